@@ -27,10 +27,16 @@ type ModelType string
 const (
 	// ModelTypeHuggingFace represents a Hugging Face model
 	ModelTypeHuggingFace ModelType = "HUGGINGFACE"
+	// ModelTypeOpenAI represents a model served by an OpenAI compatible embeddings API
+	ModelTypeOpenAI ModelType = "OPENAI"
 	// ModelTypeExternal represents an external model
 	ModelTypeExternal ModelType = "EXTERNAL"
 )
 
+// DefaultEmbeddingDimension is the dimension of the embeddings of models that
+// don't declare one.
+const DefaultEmbeddingDimension = 768
+
 // ModelState defines the state of the model
 type ModelState string
 
@@ -56,8 +62,25 @@ type ModelSpec struct {
 	Type ModelType `json:"type"`
 	// Hugging Face model spec
 	HuggingFace *HuggingFaceModelSpec `json:"huggingface,omitempty"`
+	// OpenAI model spec
+	OpenAI *OpenAIModelSpec `json:"openai,omitempty"`
+	// External model spec
+	External *ExternalModelSpec `json:"external,omitempty"`
 	// Deployment spec
 	Deployment *ModelDeploymentSpec `json:"deployment,omitempty"`
+	// Dimension of the embeddings produced by the model
+	// +kubebuilder:default=768
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Dimension int `json:"dimension,omitempty"`
+}
+
+// EmbeddingDimension returns the dimension of the embeddings produced by the model.
+func (s *ModelSpec) EmbeddingDimension() int {
+	if s.Dimension == 0 {
+		return DefaultEmbeddingDimension
+	}
+	return s.Dimension
 }
 
 // HuggingFaceModelSpec defines the desired state of HuggingFaceModel
@@ -67,6 +90,49 @@ type HuggingFaceModelSpec struct {
 	MaxSequenceLength int    `json:"maxSequenceLength"`
 }
 
+// OpenAIModelSpec defines a model served by an OpenAI compatible /v1/embeddings API,
+// either a hosted provider or a local server. The token, if any, is read from the
+// secret with the name of the model.
+type OpenAIModelSpec struct {
+	// Base URL of the API the /embeddings path is appended to
+	// +kubebuilder:default="https://api.openai.com/v1"
+	// +optional
+	BaseURL string `json:"baseURL,omitempty"`
+	// Name of the model, e.g. text-embedding-3-small
+	Model string `json:"model"`
+}
+
+// ExternalModelFormat defines the request and response format of an external model
+type ExternalModelFormat string
+
+const (
+	// ExternalModelFormatEncoder is the format of the encoder model server, whole
+	// files are sent and the server returns the embeddings of their chunks
+	ExternalModelFormatEncoder ExternalModelFormat = "ENCODER"
+	// ExternalModelFormatOpenAI is the format of the OpenAI embeddings API
+	ExternalModelFormatOpenAI ExternalModelFormat = "OPENAI"
+)
+
+// ExternalModelSpec defines a model served by an existing HTTP embedding endpoint.
+// The value of the auth header, if any, is read from the token of the secret with
+// the name of the model.
+type ExternalModelSpec struct {
+	// URL the embedding requests are posted to
+	Endpoint string `json:"endpoint"`
+	// Format of the requests and responses
+	// +kubebuilder:validation:Enum=ENCODER;OPENAI
+	// +kubebuilder:default=ENCODER
+	// +optional
+	Format ExternalModelFormat `json:"format,omitempty"`
+	// Name of the model sent with requests in the OPENAI format
+	// +optional
+	Model string `json:"model,omitempty"`
+	// Name of the header the token is sent in
+	// +kubebuilder:default=Authorization
+	// +optional
+	AuthHeader string `json:"authHeader,omitempty"`
+}
+
 // ModelStatus defines the observed state of Model
 type ModelStatus struct {
 	State      *ModelState        `json:"state,omitempty"`
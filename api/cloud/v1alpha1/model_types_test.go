@@ -0,0 +1,42 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestEmbeddingDimension(t *testing.T) {
+	tests := []struct {
+		name      string
+		dimension int
+		want      int
+	}{
+		{name: "default dimension", dimension: 0, want: DefaultEmbeddingDimension},
+		{name: "declared dimension", dimension: 1536, want: 1536},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &ModelSpec{Dimension: tt.dimension}
+			if got := model.EmbeddingDimension(); got != tt.want {
+				t.Errorf("ModelSpec.EmbeddingDimension() = %d, want %d", got, tt.want)
+			}
+			pipeline := &RepositoryEmbeddingsSpec{Dimension: tt.dimension}
+			if got := pipeline.EmbeddingDimension(); got != tt.want {
+				t.Errorf("RepositoryEmbeddingsSpec.EmbeddingDimension() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
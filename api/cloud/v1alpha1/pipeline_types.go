@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"time"
+
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -42,6 +44,279 @@ type RepositoryEmbeddingsSpec struct {
 	Model v1.ObjectReference `json:"model"`
 	// Storage spec
 	Storage v1.ObjectReference `json:"storage"`
+	// Dimension of the embeddings, copied from the model when the pipeline is
+	// created since the storage indices are created with it.
+	// +optional
+	Dimension int `json:"dimension,omitempty"`
+	// Chunking of the files, the model chunks the files if not set
+	// +optional
+	Chunking *ChunkingSpec `json:"chunking,omitempty"`
+	// Snapshots is the number of indexed commits that stay searchable after their refs
+	// moved on. Every new commit labels all files of its tree, 0 disables snapshots.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Snapshots int `json:"snapshots,omitempty"`
+	// Shards is the number of pods of an execution embedding the files in parallel,
+	// each embeds the files of a deterministic shard of the paths. The indexed commits
+	// are recorded once all shards succeeded. 0 and 1 embed the files in a single pod.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=32
+	// +optional
+	Shards int `json:"shards,omitempty"`
+}
+
+// ChunkingStrategy defines how files are split into chunks before they are embedded
+type ChunkingStrategy string
+
+const (
+	// ChunkingStrategyTokens splits files into fixed windows of tokens
+	ChunkingStrategyTokens ChunkingStrategy = "TOKENS"
+	// ChunkingStrategyLines splits files into fixed windows of lines
+	ChunkingStrategyLines ChunkingStrategy = "LINES"
+	// ChunkingStrategyLanguage splits files on the functions and classes of their language
+	ChunkingStrategyLanguage ChunkingStrategy = "LANGUAGE"
+	// ChunkingStrategySyntax splits files on the declarations of their syntax tree and
+	// records the names of the declarations
+	ChunkingStrategySyntax ChunkingStrategy = "SYNTAX"
+)
+
+// ChunkingSpec defines how the repository embedder splits files into chunks
+type ChunkingSpec struct {
+	// Strategy of the chunking
+	// +kubebuilder:validation:Enum=TOKENS;LINES;LANGUAGE;SYNTAX
+	Strategy ChunkingStrategy `json:"strategy"`
+	// Size of the chunks in tokens, or lines for the LINES strategy. Defaults to the
+	// default of the strategy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Size int `json:"size,omitempty"`
+	// Overlap of consecutive chunks in tokens, or lines for the LINES strategy.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Overlap int `json:"overlap,omitempty"`
+}
+
+// ConcurrencyPolicy describes how an execution is handled while other executions of
+// the pipeline are running
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyAllow runs executions concurrently
+	ConcurrencyPolicyAllow ConcurrencyPolicy = "Allow"
+	// ConcurrencyPolicyForbid skips an execution while other executions are running
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "Forbid"
+	// ConcurrencyPolicyReplace deletes the running executions and starts the new execution
+	// once their pods terminated
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "Replace"
+	// ConcurrencyPolicyQueue keeps an execution pending until the running executions
+	// finished. Executions queued behind another queued execution are skipped since the
+	// queued execution indexes their changes as well.
+	ConcurrencyPolicyQueue ConcurrencyPolicy = "Queue"
+)
+
+// ScheduleSpec runs the pipeline periodically, with the semantics of a CronJob
+type ScheduleSpec struct {
+	// Cron expression of the schedule in the standard format, e.g. "0 * * * *"
+	// +kubebuilder:validation:MinLength=1
+	Cron string `json:"cron"`
+	// TimeZone of the schedule, e.g. Europe/Berlin. Defaults to the time zone of the
+	// controller.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
+	// StartingDeadlineSeconds is the deadline for starting a scheduled execution that
+	// was missed. Missed executions are started regardless of their age if not set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+}
+
+// ExecutionHistory defines which finished executions of a pipeline are kept, the jobs
+// of the executions are deleted with them
+type ExecutionHistory struct {
+	// SuccessfulExecutionsLimit is the number of succeeded executions to keep. All
+	// succeeded executions are kept if not set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SuccessfulExecutionsLimit *int32 `json:"successfulExecutionsLimit,omitempty"`
+	// FailedExecutionsLimit is the number of failed, skipped or cancelled executions
+	// to keep. All of them are kept if not set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedExecutionsLimit *int32 `json:"failedExecutionsLimit,omitempty"`
+	// TTLSecondsAfterFinished deletes finished executions this long after they finished,
+	// regardless of the limits. Finished executions are only deleted by the limits if
+	// not set.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// SuccessfulLimit returns the number of succeeded executions to keep, and false if
+// all of them are kept.
+func (h *ExecutionHistory) SuccessfulLimit() (int32, bool) {
+	if h == nil || h.SuccessfulExecutionsLimit == nil {
+		return 0, false
+	}
+	return *h.SuccessfulExecutionsLimit, true
+}
+
+// FailedLimit returns the number of failed, skipped or cancelled executions to keep,
+// and false if all of them are kept.
+func (h *ExecutionHistory) FailedLimit() (int32, bool) {
+	if h == nil || h.FailedExecutionsLimit == nil {
+		return 0, false
+	}
+	return *h.FailedExecutionsLimit, true
+}
+
+// TTL returns the time finished executions are kept for, and false if they are kept
+// until the limits are reached.
+func (h *ExecutionHistory) TTL() (time.Duration, bool) {
+	if h == nil || h.TTLSecondsAfterFinished == nil {
+		return 0, false
+	}
+	return time.Duration(*h.TTLSecondsAfterFinished) * time.Second, true
+}
+
+// FailureReason is the reason an attempt of a pipeline execution failed
+type FailureReason string
+
+const (
+	// FailureReasonError is the reason of an embedder that exited with an error, e.g.
+	// since the model or the storage was unavailable
+	FailureReasonError FailureReason = "Error"
+	// FailureReasonOOMKilled is the reason of an embedder that ran out of memory
+	FailureReasonOOMKilled FailureReason = "OOMKilled"
+	// FailureReasonEvicted is the reason of an embedder whose pod was evicted or
+	// preempted, or whose node was lost
+	FailureReasonEvicted FailureReason = "Evicted"
+	// FailureReasonDeadlineExceeded is the reason of an embedder that ran longer than
+	// the active deadline of the job template of the pipeline
+	FailureReasonDeadlineExceeded FailureReason = "DeadlineExceeded"
+)
+
+const (
+	// DefaultRetryMaxAttempts is the number of attempts of an execution if the retry
+	// policy does not set it, failed attempts are not retried
+	DefaultRetryMaxAttempts = 1
+	// DefaultRetryBackoffSeconds is the delay before the second attempt of an execution
+	// if the retry policy does not set it
+	DefaultRetryBackoffSeconds = 30
+	// DefaultRetryMaxBackoffSeconds is the maximum delay between attempts of an
+	// execution if the retry policy does not set it
+	DefaultRetryMaxBackoffSeconds = 600
+)
+
+// RetryPolicy defines how failed attempts of the executions of a pipeline are retried
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts of an execution including the first, 1
+	// disables retries. Defaults to 1.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxAttempts *int32 `json:"maxAttempts,omitempty"`
+	// BackoffSeconds is the delay before the second attempt, it doubles with every
+	// further attempt. Defaults to 30.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	BackoffSeconds *int32 `json:"backoffSeconds,omitempty"`
+	// MaxBackoffSeconds is the maximum delay between attempts. Defaults to 600.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBackoffSeconds *int32 `json:"maxBackoffSeconds,omitempty"`
+	// RetryOn are the failure reasons that are retried. Defaults to Error and Evicted.
+	// +optional
+	RetryOn []FailureReason `json:"retryOn,omitempty"`
+}
+
+// Attempts returns the number of attempts of an execution.
+func (p *RetryPolicy) Attempts() int32 {
+	if p == nil || p.MaxAttempts == nil {
+		return DefaultRetryMaxAttempts
+	}
+	return *p.MaxAttempts
+}
+
+// Backoff returns the delay before the attempt following the failed attempt, attempts
+// are counted from 1.
+func (p *RetryPolicy) Backoff(failed int32) time.Duration {
+	backoff, maxBackoff := int64(DefaultRetryBackoffSeconds), int64(DefaultRetryMaxBackoffSeconds)
+	if p != nil && p.BackoffSeconds != nil {
+		backoff = int64(*p.BackoffSeconds)
+	}
+	if p != nil && p.MaxBackoffSeconds != nil {
+		maxBackoff = int64(*p.MaxBackoffSeconds)
+	}
+	for i := int32(1); i < failed && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return time.Duration(backoff) * time.Second
+}
+
+// Retryable returns true if attempts failing for the reason are retried.
+func (p *RetryPolicy) Retryable(reason FailureReason) bool {
+	retryOn := []FailureReason{FailureReasonError, FailureReasonEvicted}
+	if p != nil && len(p.RetryOn) > 0 {
+		retryOn = p.RetryOn
+	}
+	for _, r := range retryOn {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// JobTemplate overrides the job and pod of the embedder of the executions of a pipeline,
+// e.g. to run the indexing of big repositories on dedicated nodes with fixed resources
+type JobTemplate struct {
+	// Annotations added to the pods of the jobs
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Resources of the embedder container
+	// +optional
+	Resources v1.ResourceRequirements `json:"resources,omitempty"`
+	// Env are additional environment variables of the embedder container, e.g. proxies
+	// +optional
+	Env []v1.EnvVar `json:"env,omitempty"`
+	// NodeSelector of the pods of the jobs
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations of the pods of the jobs
+	// +optional
+	Tolerations []v1.Toleration `json:"tolerations,omitempty"`
+	// Affinity of the pods of the jobs
+	// +optional
+	Affinity *v1.Affinity `json:"affinity,omitempty"`
+	// PriorityClassName of the pods of the jobs
+	// +optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+	// ImagePullSecrets of the embedder image
+	// +optional
+	ImagePullSecrets []v1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// ActiveDeadlineSeconds is the time an attempt may run before it is stopped and fails
+	// with the DeadlineExceeded reason
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// ShardCount returns the number of shards the files of an execution are embedded in.
+func (s *RepositoryEmbeddingsSpec) ShardCount() int {
+	if s.Shards < 1 {
+		return 1
+	}
+	return s.Shards
+}
+
+// EmbeddingDimension returns the dimension of the embeddings of the pipeline.
+func (s *RepositoryEmbeddingsSpec) EmbeddingDimension() int {
+	if s.Dimension == 0 {
+		return DefaultEmbeddingDimension
+	}
+	return s.Dimension
 }
 
 // PipelineSpec defines the desired state of Pipeline
@@ -54,11 +329,53 @@ type PipelineSpec struct {
 	Enabled bool `json:"enabled"`
 	// RepositoryEmbeddings pipeline spec
 	RepositoryEmbeddings *RepositoryEmbeddingsSpec `json:"repositoryembeddings,omitempty"`
+	// ConcurrencyPolicy of the executions of the pipeline. Defaults to Allow, Queue
+	// keeps concurrent executions from writing the same embeddings.
+	// +kubebuilder:validation:Enum=Allow;Forbid;Replace;Queue
+	// +kubebuilder:default=Allow
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+	// RetryPolicy of the failed attempts of the executions of the pipeline, failed
+	// attempts are not retried if not set
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+	// JobTemplate overrides the job and pod of the embedder of the executions
+	// +optional
+	JobTemplate *JobTemplate `json:"jobTemplate,omitempty"`
+	// ExecutionHistory limits the finished executions of the pipeline that are kept,
+	// all finished executions are kept if not set
+	// +optional
+	ExecutionHistory *ExecutionHistory `json:"executionHistory,omitempty"`
+	// Schedule runs the pipeline periodically while it is enabled. Scheduled runs are
+	// postponed while executions are running if the concurrency policy is Forbid.
+	// +optional
+	Schedule *ScheduleSpec `json:"schedule,omitempty"`
 }
 
 // PipelineStatus defines the observed state of Pipeline
 type PipelineStatus struct {
 	State *PipelineState `json:"state,omitempty"`
+	// IndexedCommits are the commits indexed by the last successful execution, one per
+	// ref. The next execution only indexes the changes since these commits.
+	// +optional
+	IndexedCommits []IndexedCommit `json:"indexedCommits,omitempty"`
+	// Snapshots are the indexed commits that can be searched, newest first
+	// +optional
+	Snapshots []IndexedCommit `json:"snapshots,omitempty"`
+	// LastScheduleTime is the time the last scheduled execution was created
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+}
+
+// IndexedCommit is the commit of a ref indexed by a pipeline
+type IndexedCommit struct {
+	// Ref is the full name of the ref, e.g. refs/heads/main
+	Ref string `json:"ref"`
+	// Commit is the hash of the commit
+	Commit string `json:"commit"`
+	// Tree is the hash of the tree of the commit
+	// +optional
+	Tree string `json:"tree,omitempty"`
 }
 
 //+kubebuilder:object:root=true
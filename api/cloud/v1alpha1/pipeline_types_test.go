@@ -0,0 +1,167 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+)
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func TestRetryPolicyAttempts(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *RetryPolicy
+		want   int32
+	}{
+		{name: "no policy", policy: nil, want: 1},
+		{name: "policy without attempts", policy: &RetryPolicy{BackoffSeconds: int32Ptr(10)}, want: 1},
+		{name: "attempts of the policy", policy: &RetryPolicy{MaxAttempts: int32Ptr(5)}, want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Attempts(); got != tt.want {
+				t.Errorf("Attempts() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *RetryPolicy
+		failed int32
+		want   time.Duration
+	}{
+		{name: "default after the first attempt", policy: nil, failed: 1, want: 30 * time.Second},
+		{name: "default doubles", policy: nil, failed: 2, want: time.Minute},
+		{name: "default doubles again", policy: nil, failed: 3, want: 2 * time.Minute},
+		{name: "default is capped", policy: nil, failed: 6, want: 10 * time.Minute},
+		{name: "default stays capped", policy: nil, failed: 40, want: 10 * time.Minute},
+		{name: "backoff of the policy", policy: &RetryPolicy{BackoffSeconds: int32Ptr(5)}, failed: 1, want: 5 * time.Second},
+		{name: "backoff of the policy doubles", policy: &RetryPolicy{BackoffSeconds: int32Ptr(5)}, failed: 4, want: 40 * time.Second},
+		{name: "maximum of the policy", policy: &RetryPolicy{BackoffSeconds: int32Ptr(5), MaxBackoffSeconds: int32Ptr(12)}, failed: 3, want: 12 * time.Second},
+		{name: "backoff above the maximum", policy: &RetryPolicy{BackoffSeconds: int32Ptr(60), MaxBackoffSeconds: int32Ptr(10)}, failed: 1, want: 10 * time.Second},
+		{name: "no backoff", policy: &RetryPolicy{BackoffSeconds: int32Ptr(0)}, failed: 3, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Backoff(tt.failed); got != tt.want {
+				t.Errorf("Backoff(%d) = %v, want %v", tt.failed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyRetryable(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *RetryPolicy
+		reason FailureReason
+		want   bool
+	}{
+		{name: "default retries errors", policy: nil, reason: FailureReasonError, want: true},
+		{name: "default retries evictions", policy: nil, reason: FailureReasonEvicted, want: true},
+		{name: "default does not retry OOM kills", policy: nil, reason: FailureReasonOOMKilled, want: false},
+		{name: "default does not retry deadlines", policy: nil, reason: FailureReasonDeadlineExceeded, want: false},
+		{name: "empty retryOn uses the default", policy: &RetryPolicy{RetryOn: []FailureReason{}}, reason: FailureReasonError, want: true},
+		{name: "reason of the policy", policy: &RetryPolicy{RetryOn: []FailureReason{FailureReasonOOMKilled}}, reason: FailureReasonOOMKilled, want: true},
+		{name: "reason not in the policy", policy: &RetryPolicy{RetryOn: []FailureReason{FailureReasonOOMKilled}}, reason: FailureReasonError, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Retryable(tt.reason); got != tt.want {
+				t.Errorf("Retryable(%s) = %v, want %v", tt.reason, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecutionHistory(t *testing.T) {
+	tests := []struct {
+		name                string
+		history             *ExecutionHistory
+		wantSuccessful      int32
+		wantLimitSuccessful bool
+		wantFailed          int32
+		wantLimitFailed     bool
+		wantTTL             time.Duration
+		wantExpires         bool
+	}{
+		{name: "no history", history: nil},
+		{
+			name:                "limits of the history",
+			history:             &ExecutionHistory{SuccessfulExecutionsLimit: int32Ptr(0), FailedExecutionsLimit: int32Ptr(5)},
+			wantSuccessful:      0,
+			wantLimitSuccessful: true,
+			wantFailed:          5,
+			wantLimitFailed:     true,
+		},
+		{
+			name:            "failed limit only",
+			history:         &ExecutionHistory{FailedExecutionsLimit: int32Ptr(1)},
+			wantFailed:      1,
+			wantLimitFailed: true,
+		},
+		{
+			name:        "TTL of the history",
+			history:     &ExecutionHistory{TTLSecondsAfterFinished: int32Ptr(3600)},
+			wantTTL:     time.Hour,
+			wantExpires: true,
+		},
+		{
+			name:        "zero TTL",
+			history:     &ExecutionHistory{TTLSecondsAfterFinished: int32Ptr(0)},
+			wantExpires: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, limit := tt.history.SuccessfulLimit(); got != tt.wantSuccessful || limit != tt.wantLimitSuccessful {
+				t.Errorf("SuccessfulLimit() = %d, %v, want %d, %v", got, limit, tt.wantSuccessful, tt.wantLimitSuccessful)
+			}
+			if got, limit := tt.history.FailedLimit(); got != tt.wantFailed || limit != tt.wantLimitFailed {
+				t.Errorf("FailedLimit() = %d, %v, want %d, %v", got, limit, tt.wantFailed, tt.wantLimitFailed)
+			}
+			ttl, expires := tt.history.TTL()
+			if ttl != tt.wantTTL || expires != tt.wantExpires {
+				t.Errorf("TTL() = %v, %v, want %v, %v", ttl, expires, tt.wantTTL, tt.wantExpires)
+			}
+		})
+	}
+}
+
+func TestShardCount(t *testing.T) {
+	tests := []struct {
+		shards int
+		want   int
+	}{
+		{shards: 0, want: 1},
+		{shards: 1, want: 1},
+		{shards: 8, want: 8},
+	}
+	for _, tt := range tests {
+		spec := &RepositoryEmbeddingsSpec{Shards: tt.shards}
+		if got := spec.ShardCount(); got != tt.want {
+			t.Errorf("ShardCount() of %d shards = %d, want %d", tt.shards, got, tt.want)
+		}
+	}
+}
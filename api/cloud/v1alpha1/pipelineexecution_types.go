@@ -32,22 +32,154 @@ const (
 	PipelineExecutionStateFailed PipelineExecutionState = "FAILED"
 	// Pending represents a pending pipeline execution
 	PipelineExecutionStatePending PipelineExecutionState = "PENDING"
+	// Skipped represents a pipeline execution that was not started due to the
+	// concurrency policy of the pipeline
+	PipelineExecutionStateSkipped PipelineExecutionState = "SKIPPED"
+	// Cancelled represents a pipeline execution that was stopped on request
+	PipelineExecutionStateCancelled PipelineExecutionState = "CANCELLED"
 )
 
+// PipelineExecutionConditionAdmitted tells whether the execution was started by the
+// concurrency policy of the pipeline, the reason is one of the admission reasons below.
+const PipelineExecutionConditionAdmitted = "Admitted"
+
+const (
+	// AdmissionReasonStarted is the reason of a started execution
+	AdmissionReasonStarted = "Started"
+	// AdmissionReasonQueued is the reason of an execution waiting for the running executions
+	AdmissionReasonQueued = "Queued"
+	// AdmissionReasonForbidden is the reason of an execution skipped since executions were running
+	AdmissionReasonForbidden = "ConcurrencyForbidden"
+	// AdmissionReasonDuplicate is the reason of an execution skipped since another
+	// execution is queued
+	AdmissionReasonDuplicate = "Duplicate"
+)
+
+// ScheduledAtAnnotation is set on the executions created by the schedule of a pipeline
+// to the time they were scheduled for, in RFC 3339.
+const ScheduledAtAnnotation = "cloud.encoder.run/scheduled-at"
+
 // PipelineExecutionSpec defines the desired state of PipelineExecution
 type PipelineExecutionSpec struct {
 	// PipelineRef is a reference to the pipeline
 	PipelineRef v1.ObjectReference `json:"pipelineRef"`
 	// Metadata is a map of metadata
 	Metadata map[string]string `json:"metadata,omitempty"`
+	// Cancelled requests the execution to be stopped, the job of the running attempt
+	// is deleted and no further attempts are started
+	// +optional
+	Cancelled bool `json:"cancelled,omitempty"`
 }
 
 // PipelineExecutionStatus defines the observed state of PipelineExecution
 type PipelineExecutionStatus struct {
 	State      *PipelineExecutionState `json:"state,omitempty"`
 	Conditions []metav1.Condition      `json:"conditions,omitempty"`
+	// StartTime is the time the job of the first attempt was created
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is the time the execution succeeded, failed, was skipped or cancelled
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Reason the execution failed, the reason of its last attempt
+	// +optional
+	Reason FailureReason `json:"reason,omitempty"`
+	// Message of the failure or of the skipped execution
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Commits are the commits indexed by the execution, one per ref
+	Commits []IndexedCommit `json:"commits,omitempty"`
+	// Stats are the counters reported by the embedder once it indexed the commits
+	// +optional
+	Stats *ExecutionStats `json:"stats,omitempty"`
+	// Progress is reported periodically by the embedder of the running attempt
+	// +optional
+	Progress *ExecutionProgress `json:"progress,omitempty"`
+	// ShardProgress is the progress of the shards of the running attempt of a sharded
+	// execution, keyed by the shard index
+	// +optional
+	ShardProgress map[string]ExecutionProgress `json:"shardProgress,omitempty"`
+	// ShardStats are the stats of the shards of a sharded execution, keyed by the shard
+	// index. They are added to the stats once the indexed commits are recorded.
+	// +optional
+	ShardStats map[string]ExecutionStats `json:"shardStats,omitempty"`
+	// Attempts are the attempts of the execution, each running a job
+	// +optional
+	Attempts []ExecutionAttempt `json:"attempts,omitempty"`
+	// NextAttemptTime is the time the failed attempt is retried at
+	// +optional
+	NextAttemptTime *metav1.Time `json:"nextAttemptTime,omitempty"`
+}
+
+// ExecutionStats are the counters of the files and chunks of a pipeline execution
+type ExecutionStats struct {
+	// FilesScanned is the number of supported files of the trees or changes of the refs
+	FilesScanned int64 `json:"filesScanned"`
+	// FilesSkipped is the number of scanned files that were already embedded
+	FilesSkipped int64 `json:"filesSkipped"`
+	// FilesEmbedded is the number of files that were embedded
+	FilesEmbedded int64 `json:"filesEmbedded"`
+	// FilesDeleted is the number of files removed from the index of a ref, or deleted
+	// from the storage
+	FilesDeleted int64 `json:"filesDeleted"`
+	// ChunksWritten is the number of chunks saved in the storage
+	ChunksWritten int64 `json:"chunksWritten"`
+}
+
+// Add adds the counters of the other stats.
+func (s *ExecutionStats) Add(other ExecutionStats) {
+	s.FilesScanned += other.FilesScanned
+	s.FilesSkipped += other.FilesSkipped
+	s.FilesEmbedded += other.FilesEmbedded
+	s.FilesDeleted += other.FilesDeleted
+	s.ChunksWritten += other.ChunksWritten
+}
+
+// ExecutionProgress is the progress of the embedder of a pipeline execution
+type ExecutionProgress struct {
+	// FilesProcessed is the number of files of the trees or changes of the refs processed
+	FilesProcessed int64 `json:"filesProcessed"`
+	// FilesTotal is the number of files of the trees or changes of the refs known so far
+	FilesTotal int64 `json:"filesTotal"`
+	// Batch is the number of batches of files embedded so far
+	Batch int64 `json:"batch"`
+	// EstimatedCompletionTime is extrapolated from the files processed so far
+	// +optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty"`
+	// UpdateTime is the time the progress was reported
+	UpdateTime metav1.Time `json:"updateTime"`
+}
+
+// ExecutionAttempt is an attempt of a pipeline execution
+type ExecutionAttempt struct {
+	// Attempt is the number of the attempt, starting at 1
+	Attempt int32 `json:"attempt"`
+	// JobName is the name of the job of the attempt, an indexed job with a pod per
+	// shard if the execution is sharded
+	JobName string `json:"jobName"`
+	// FinalizeJobName is the name of the job recording the indexed commits once all
+	// shards of a sharded attempt succeeded
+	// +optional
+	FinalizeJobName string `json:"finalizeJobName,omitempty"`
+	// State of the attempt, ACTIVE, SUCCEEDED or FAILED
+	State PipelineExecutionState `json:"state"`
+	// StartTime is the time the job of the attempt was created
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is the time the attempt succeeded or failed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// Reason the attempt failed
+	// +optional
+	Reason FailureReason `json:"reason,omitempty"`
+	// Message of the failure, e.g. the last log lines of the embedder
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
+// ExecutionJobLabel is set on the jobs of the attempts of a pipeline execution to the
+// name of the execution.
+const ExecutionJobLabel = "pipelineExecutionId"
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -60,6 +192,20 @@ type PipelineExecution struct {
 	Status PipelineExecutionStatus `json:"status,omitempty"`
 }
 
+// Finished returns true if the execution succeeded, failed, was skipped or cancelled.
+// Executions retrying a failed attempt are still active.
+func (pe *PipelineExecution) Finished() bool {
+	if pe.Status.State == nil {
+		return false
+	}
+	switch *pe.Status.State {
+	case PipelineExecutionStateSucceeded, PipelineExecutionStateFailed, PipelineExecutionStateSkipped,
+		PipelineExecutionStateCancelled:
+		return true
+	}
+	return false
+}
+
 //+kubebuilder:object:root=true
 
 // PipelineExecutionList contains a list of PipelineExecution
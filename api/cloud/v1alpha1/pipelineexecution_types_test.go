@@ -0,0 +1,55 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import "testing"
+
+func TestExecutionStatsAdd(t *testing.T) {
+	stats := ExecutionStats{FilesScanned: 1, FilesSkipped: 2, FilesEmbedded: 3, FilesDeleted: 4, ChunksWritten: 5}
+	stats.Add(ExecutionStats{FilesScanned: 10, FilesSkipped: 20, FilesEmbedded: 30, FilesDeleted: 40, ChunksWritten: 50})
+	want := ExecutionStats{FilesScanned: 11, FilesSkipped: 22, FilesEmbedded: 33, FilesDeleted: 44, ChunksWritten: 55}
+	if stats != want {
+		t.Errorf("Add() = %+v, want %+v", stats, want)
+	}
+}
+
+func TestPipelineExecutionFinished(t *testing.T) {
+	tests := []struct {
+		name  string
+		state PipelineExecutionState
+		want  bool
+	}{
+		{name: "pending", want: false},
+		{name: "active", state: PipelineExecutionStateActive, want: false},
+		{name: "succeeded", state: PipelineExecutionStateSucceeded, want: true},
+		{name: "failed", state: PipelineExecutionStateFailed, want: true},
+		{name: "skipped", state: PipelineExecutionStateSkipped, want: true},
+		{name: "cancelled", state: PipelineExecutionStateCancelled, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pe := &PipelineExecution{}
+			if tt.state != "" {
+				state := tt.state
+				pe.Status.State = &state
+			}
+			if got := pe.Finished(); got != tt.want {
+				t.Errorf("Finished() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
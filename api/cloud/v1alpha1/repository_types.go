@@ -17,6 +17,9 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"path"
+	"strings"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -30,14 +33,61 @@ const (
 	RepositoryTypeGitlab RepositoryType = "GITLAB"
 	// RepositoryTypeBitbucket represents a Bitbucket repository
 	RepositoryTypeBitbucket RepositoryType = "BITBUCKET"
+	// RepositoryTypeGit represents a repository on any git remote
+	RepositoryTypeGit RepositoryType = "GIT"
 )
 
+// WebhookSecretKey is the key of the secret named after the repository holding the
+// secret the push webhooks of the repository are signed with
+const WebhookSecretKey = "webhook-secret"
+
 // RepositorySpec defines the desired state of Repository
 type RepositorySpec struct {
 	// Type of repository
 	Type RepositoryType `json:"type"`
 	// Github repository spec
 	Github *GithubRepositorySpec `json:"github,omitempty"`
+	// Gitlab repository spec
+	Gitlab *GitlabRepositorySpec `json:"gitlab,omitempty"`
+	// Bitbucket repository spec
+	Bitbucket *BitbucketRepositorySpec `json:"bitbucket,omitempty"`
+	// Git repository spec
+	Git *GitRepositorySpec `json:"git,omitempty"`
+	// Refs to index, e.g. main, v1.0, release/* or refs/tags/v*. Names and patterns
+	// without the refs/ prefix match both branches and tags. The branch of the
+	// repository is indexed if empty.
+	// +optional
+	Refs []string `json:"refs,omitempty"`
+}
+
+// IndexedRefs returns the refs to index, the branch of the repository if no refs are set.
+func (s *RepositorySpec) IndexedRefs() []string {
+	if len(s.Refs) > 0 {
+		return s.Refs
+	}
+	var branch string
+	switch {
+	case s.Github != nil:
+		branch = s.Github.Branch
+	case s.Gitlab != nil:
+		branch = s.Gitlab.Branch
+	case s.Bitbucket != nil:
+		branch = s.Bitbucket.Branch
+	case s.Git != nil:
+		branch = s.Git.Branch
+	}
+	return []string{branch}
+}
+
+// MatchRef reports whether the full name of a branch or tag, e.g. refs/heads/main,
+// matches the ref pattern. Patterns without the refs/ prefix match the short name of
+// the ref.
+func MatchRef(pattern, ref string) (bool, error) {
+	if strings.HasPrefix(pattern, "refs/") {
+		return path.Match(pattern, ref)
+	}
+	short := strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/")
+	return path.Match(pattern, short)
 }
 
 // GithubRepositorySpec defines the desired state of a Github repository
@@ -52,6 +102,47 @@ type GithubRepositorySpec struct {
 	Branch string `json:"branch,omitempty"`
 }
 
+// GitlabRepositorySpec defines the desired state of a Gitlab repository. The access
+// token is read from the "token" key of the secret named after the repository.
+type GitlabRepositorySpec struct {
+	// BaseURL of the Gitlab instance, e.g. https://gitlab.com
+	BaseURL string `json:"baseURL"`
+	// ProjectPath is the full path of the project including its groups, e.g. group/subgroup/project
+	ProjectPath string `json:"projectPath"`
+	// URL of the repository
+	URL string `json:"url"`
+	// Branch of the repository
+	Branch string `json:"branch,omitempty"`
+}
+
+// BitbucketRepositorySpec defines the desired state of a Bitbucket repository. The
+// secret named after the repository holds either an app password in the "username"
+// and "password" keys or a repository, project or workspace access token in the "token" key.
+type BitbucketRepositorySpec struct {
+	// Workspace the repository belongs to
+	Workspace string `json:"workspace"`
+	// Name of the repository (the repository slug)
+	Name string `json:"name"`
+	// URL of the repository
+	URL string `json:"url"`
+	// Branch of the repository
+	Branch string `json:"branch,omitempty"`
+}
+
+// GitRepositorySpec defines the desired state of a repository on any git remote. The
+// optional secret named after the repository holds an SSH private key in the
+// "ssh-privatekey" key, verified against the "known_hosts" key and decrypted with the
+// optional "passphrase" key, or "username" and "password" or "token" keys for http remotes.
+type GitRepositorySpec struct {
+	// CloneURL of the repository, e.g. ssh://git@host/repo.git, git@host:repo.git,
+	// https://host/repo.git or file:///srv/git/repo.git
+	CloneURL string `json:"cloneURL"`
+	// URL of the repository without scheme, user or port
+	URL string `json:"url"`
+	// Branch of the repository
+	Branch string `json:"branch,omitempty"`
+}
+
 // RepositoryStatus defines the observed state of Repository
 type RepositoryStatus struct {
 }
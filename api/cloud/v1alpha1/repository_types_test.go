@@ -0,0 +1,83 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIndexedRefs(t *testing.T) {
+	tests := []struct {
+		name string
+		spec RepositorySpec
+		want []string
+	}{
+		{
+			name: "refs of the repository",
+			spec: RepositorySpec{Github: &GithubRepositorySpec{Branch: "main"}, Refs: []string{"release/*", "refs/tags/v*"}},
+			want: []string{"release/*", "refs/tags/v*"},
+		},
+		{name: "github branch", spec: RepositorySpec{Github: &GithubRepositorySpec{Branch: "main"}}, want: []string{"main"}},
+		{name: "gitlab branch", spec: RepositorySpec{Gitlab: &GitlabRepositorySpec{Branch: "develop"}}, want: []string{"develop"}},
+		{name: "bitbucket branch", spec: RepositorySpec{Bitbucket: &BitbucketRepositorySpec{Branch: "master"}}, want: []string{"master"}},
+		{name: "git branch", spec: RepositorySpec{Git: &GitRepositorySpec{Branch: "trunk"}}, want: []string{"trunk"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.spec.IndexedRefs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IndexedRefs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		ref     string
+		want    bool
+		wantErr bool
+	}{
+		{name: "branch by short name", pattern: "main", ref: "refs/heads/main", want: true},
+		{name: "tag by short name", pattern: "v1.0.0", ref: "refs/tags/v1.0.0", want: true},
+		{name: "other branch", pattern: "main", ref: "refs/heads/develop", want: false},
+		{name: "short name is not a prefix", pattern: "main", ref: "refs/heads/main-old", want: false},
+		{name: "glob over branches", pattern: "release/*", ref: "refs/heads/release/1.2", want: true},
+		{name: "glob does not cross slashes", pattern: "release/*", ref: "refs/heads/release/1.2/hotfix", want: false},
+		{name: "glob over tags", pattern: "v*", ref: "refs/tags/v2.1.0", want: true},
+		{name: "short name matches branches and tags", pattern: "v*", ref: "refs/heads/v2", want: true},
+		{name: "full name of a branch", pattern: "refs/heads/main", ref: "refs/heads/main", want: true},
+		{name: "full name of a branch does not match a tag", pattern: "refs/heads/v1", ref: "refs/tags/v1", want: false},
+		{name: "full name glob over tags only", pattern: "refs/tags/*", ref: "refs/tags/v1.0.0", want: true},
+		{name: "full name glob over tags skips branches", pattern: "refs/tags/*", ref: "refs/heads/main", want: false},
+		{name: "other refs keep their full name", pattern: "*", ref: "refs/pull/1/head", want: false},
+		{name: "invalid pattern", pattern: "release/[", ref: "refs/heads/release/1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchRef(tt.pattern, tt.ref)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MatchRef(%q, %q) error = %v, wantErr %v", tt.pattern, tt.ref, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("MatchRef(%q, %q) = %v, want %v", tt.pattern, tt.ref, got, tt.want)
+			}
+		})
+	}
+}
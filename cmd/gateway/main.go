@@ -4,13 +4,20 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"slices"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/encoder-run/operator/cmd/gateway/middleware"
+	"github.com/encoder-run/operator/cmd/gateway/webhooks"
 	"github.com/encoder-run/operator/pkg/graph"
 
 	"github.com/go-chi/chi"
+	"github.com/gorilla/websocket"
 	"github.com/rs/cors"
 )
 
@@ -30,12 +37,14 @@ func main() {
 	// Create a router instance
 	router := chi.NewRouter()
 
+	allowedOrigins := []string{
+		// Move these to environment variables.
+		"http://localhost:3000",
+		"http://localhost:32081",
+	}
+
 	router.Use(cors.New(cors.Options{
-		AllowedOrigins: []string{
-			// Move these to environment variables.
-			"http://localhost:3000",
-			"http://localhost:32081",
-		},
+		AllowedOrigins: allowedOrigins,
 		AllowedMethods: []string{"GET", "HEAD", "POST", "PUT", "OPTIONS"},
 		AllowedHeaders: []string{"Content-Type"},
 		Debug:          true,
@@ -48,10 +57,31 @@ func main() {
 	router.Use(middleware.K8sImpersonationMiddleware(km))
 
 	// GraphQL server setup
-	srv := handler.NewDefaultServer(graph.NewExecutableSchema(graph.Config{Resolvers: &graph.Resolver{}}))
+	// GraphQL server setup, subscriptions are served over websockets
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: &graph.Resolver{}}))
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			// Websockets are not covered by CORS, the console is served from another origin.
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || slices.Contains(allowedOrigins, origin)
+			},
+		},
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New(1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New(100)})
 	router.Handle("/", playground.Handler("GraphQL playground", "/query"))
 	router.Handle("/query", srv)
 
+	// Webhooks of the git providers triggering the pipelines on push
+	router.Post("/webhooks/github", webhooks.GitHub)
+
 	log.Printf("connect to http://localhost:%s/ for GraphQL playground", port)
 	log.Fatal(http.ListenAndServe(":"+port, router))
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
 
 	cloudv1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
 	"github.com/encoder-run/operator/pkg/common"
@@ -12,14 +13,80 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-type K8sClientManager struct{}
+type K8sClientManager struct {
+	mu sync.Mutex
+	// clients are the admin controller-runtime clients keyed by the namespace of the
+	// impersonated service account, they are safe to share between requests.
+	clients map[string]client.Client
+	// clientsets are the admin clientsets keyed by the namespace of the impersonated
+	// service account, they are safe to share between requests.
+	clientsets map[string]kubernetes.Interface
+}
 
+// AdminClient returns a controller-runtime client impersonating the admin service
+// account of the namespace. The client and its REST mapper are created once per namespace.
 func (km *K8sClientManager) AdminClient(namespace string) (client.Client, error) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+	if c, ok := km.clients[namespace]; ok {
+		return c, nil
+	}
+
+	config, err := adminConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a new scheme and register the API types
+	scheme := NewScheme()
+
+	// Create the controller-runtime client using the impersonated rest.Config
+	c, err := client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create controller-runtime client: %v", err)
+	}
+
+	if km.clients == nil {
+		km.clients = make(map[string]client.Client)
+	}
+	km.clients[namespace] = c
+	return c, nil
+}
+
+// AdminClientset returns a clientset impersonating the admin service account like the
+// admin client. The clientset is created once per namespace.
+func (km *K8sClientManager) AdminClientset(namespace string) (kubernetes.Interface, error) {
+	km.mu.Lock()
+	defer km.mu.Unlock()
+	if cs, ok := km.clientsets[namespace]; ok {
+		return cs, nil
+	}
+
+	config, err := adminConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	cs, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %v", err)
+	}
+
+	if km.clientsets == nil {
+		km.clientsets = make(map[string]kubernetes.Interface)
+	}
+	km.clientsets[namespace] = cs
+	return cs, nil
+}
+
+// adminConfig returns the rest.Config impersonating the admin service account of the namespace.
+func adminConfig(namespace string) (*rest.Config, error) {
 	config, err := rest.InClusterConfig()
 	if err != nil {
 		// Load kubeconfig from default location or specified path
@@ -35,16 +102,7 @@ func (km *K8sClientManager) AdminClient(namespace string) (client.Client, error)
 
 	config.Impersonate.UserName = "system:serviceaccount:" + namespace + ":" + "admin"
 
-	// Create a new scheme and register the API types
-	scheme := NewScheme()
-
-	// Create the controller-runtime client using the impersonated rest.Config
-	c, err := client.New(config, client.Options{Scheme: scheme})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create controller-runtime client: %v", err)
-	}
-
-	return c, nil
+	return config, nil
 }
 
 func NewScheme() *runtime.Scheme {
@@ -67,8 +125,15 @@ func K8sImpersonationMiddleware(km *K8sClientManager) mux.MiddlewareFunc {
 				return
 			}
 
-			// Attach the client to the context
+			clientset, err := km.AdminClientset("default")
+			if err != nil {
+				http.Error(w, "Failed to initialize Kubernetes client", http.StatusInternalServerError)
+				return
+			}
+
+			// Attach the clients to the context
 			ctx := context.WithValue(r.Context(), common.AdminClientKey, client)
+			ctx = context.WithValue(ctx, common.AdminClientsetKey, clientset)
 
 			// Proceed with the next handler
 			next.ServeHTTP(w, r.WithContext(ctx))
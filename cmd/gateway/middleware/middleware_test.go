@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"sync"
+	"testing"
+
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAdminClientsetCached(t *testing.T) {
+	cs := kubefake.NewSimpleClientset()
+	km := &K8sClientManager{}
+	km.clientsets = map[string]kubernetes.Interface{"default": cs}
+
+	// Concurrent requests share the clientset of the namespace.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := km.AdminClientset("default")
+			if err != nil {
+				t.Errorf("AdminClientset() error = %v", err)
+				return
+			}
+			if got != cs {
+				t.Errorf("AdminClientset() = %v, want the cached clientset", got)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAdminClientCached(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(NewScheme()).Build()
+	km := &K8sClientManager{}
+	km.clients = map[string]client.Client{"default": c}
+
+	// Concurrent requests share the client of the namespace.
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			got, err := km.AdminClient("default")
+			if err != nil {
+				t.Errorf("AdminClient() error = %v", err)
+				return
+			}
+			if got != c {
+				t.Errorf("AdminClient() = %v, want the cached client", got)
+			}
+		}()
+	}
+	wg.Wait()
+}
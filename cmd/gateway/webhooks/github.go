@@ -0,0 +1,250 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/common"
+	"github.com/encoder-run/operator/pkg/graph/converters"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxPayloadSize is the maximum size of the payloads Github sends.
+const maxPayloadSize = 25 << 20
+
+// githubPayload holds the fields of the ping and push events that are used.
+type githubPayload struct {
+	Ref        string `json:"ref"`
+	After      string `json:"after"`
+	Deleted    bool   `json:"deleted"`
+	Repository struct {
+		FullName string `json:"full_name"`
+		CloneURL string `json:"clone_url"`
+	} `json:"repository"`
+}
+
+// GitHub handles the ping and push events of Github webhooks. The payload is verified
+// against the webhook secret of the repositories it was sent for and a push creates an
+// execution of every enabled pipeline of the repositories indexing the pushed ref.
+func GitHub(w http.ResponseWriter, r *http.Request) {
+	ctrlClient, ok := r.Context().Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		http.Error(w, "controller-runtime client not found in context", http.StatusInternalServerError)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxPayloadSize))
+	if err != nil {
+		http.Error(w, "failed to read the payload", http.StatusBadRequest)
+		return
+	}
+	var payload githubPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+	if payload.Repository.CloneURL == "" {
+		http.Error(w, "the payload has no repository", http.StatusBadRequest)
+		return
+	}
+
+	repos, err := matchingRepositories(r, ctrlClient, payload.Repository.CloneURL)
+	if err != nil {
+		log.Printf("failed to find the repositories of %s: %v", payload.Repository.FullName, err)
+		http.Error(w, "failed to find the repositories", http.StatusInternalServerError)
+		return
+	}
+
+	// Only repositories whose webhook secret signed the payload are used. Payloads of
+	// unknown repositories and failed verifications get the same response as invalid
+	// signatures so the registered repositories can't be probed without a secret.
+	signature := r.Header.Get("X-Hub-Signature-256")
+	verified := make([]v1alpha1.Repository, 0, len(repos))
+	for _, repo := range repos {
+		ok, err := verifySignature(r, ctrlClient, &repo, body, signature)
+		if err != nil {
+			log.Printf("failed to verify the signature for repository %s: %v", repo.Name, err)
+			continue
+		}
+		if ok {
+			verified = append(verified, repo)
+		}
+	}
+	if len(verified) == 0 {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	switch event := r.Header.Get("X-GitHub-Event"); event {
+	case "ping":
+		fmt.Fprintln(w, "pong")
+		return
+	case "push":
+	default:
+		http.Error(w, fmt.Sprintf("unsupported event %q", event), http.StatusBadRequest)
+		return
+	}
+
+	if payload.Deleted || payload.Ref == "" {
+		// Deleted refs are removed from the index by the next execution.
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	executions := make([]string, 0)
+	for _, repo := range verified {
+		indexed, err := indexesRef(&repo, payload.Ref)
+		if err != nil {
+			log.Printf("failed to match the refs of repository %s: %v", repo.Name, err)
+			continue
+		}
+		if !indexed {
+			continue
+		}
+		names, err := triggerPipelines(r, ctrlClient, &repo, &payload, r.Header.Get("X-GitHub-Delivery"))
+		if err != nil {
+			log.Printf("failed to trigger the pipelines of repository %s: %v", repo.Name, err)
+			http.Error(w, "failed to trigger the pipelines", http.StatusInternalServerError)
+			return
+		}
+		executions = append(executions, names...)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(map[string][]string{"executions": executions})
+}
+
+// matchingRepositories returns the repositories cloned from the clone URL.
+func matchingRepositories(r *http.Request, c client.Client, cloneURL string) ([]v1alpha1.Repository, error) {
+	url, err := converters.GitRepositoryURL(cloneURL)
+	if err != nil {
+		return nil, err
+	}
+
+	repoList := &v1alpha1.RepositoryList{}
+	if err := c.List(r.Context(), repoList, &client.ListOptions{Namespace: "default"}); err != nil {
+		return nil, err
+	}
+	repos := make([]v1alpha1.Repository, 0)
+	for _, repo := range repoList.Items {
+		repoURL, err := converters.RepositoryCRDURL(&repo)
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(repoURL, url) {
+			repos = append(repos, repo)
+		}
+	}
+	return repos, nil
+}
+
+// verifySignature checks the sha256 HMAC signature of the payload with the webhook
+// secret of the repository. Repositories without a webhook secret never match.
+func verifySignature(r *http.Request, c client.Client, repo *v1alpha1.Repository, body []byte, signature string) (bool, error) {
+	hexSum, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok {
+		return false, nil
+	}
+	sum, err := hex.DecodeString(hexSum)
+	if err != nil {
+		return false, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(r.Context(), client.ObjectKey{Name: repo.Name, Namespace: repo.Namespace}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	key, ok := secret.Data[v1alpha1.WebhookSecretKey]
+	if !ok || len(key) == 0 {
+		return false, nil
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), sum), nil
+}
+
+// indexesRef reports whether the repository indexes the full name of the ref.
+func indexesRef(repo *v1alpha1.Repository, ref string) (bool, error) {
+	for _, pattern := range repo.Spec.IndexedRefs() {
+		ok, err := v1alpha1.MatchRef(pattern, ref)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// triggerPipelines creates an execution of every enabled pipeline of the repository and
+// returns their names. Executions are named after the delivery so redelivered events
+// don't index the repository again.
+func triggerPipelines(r *http.Request, c client.Client, repo *v1alpha1.Repository, payload *githubPayload, delivery string) ([]string, error) {
+	pipelineList := &v1alpha1.PipelineList{}
+	if err := c.List(r.Context(), pipelineList, &client.ListOptions{Namespace: repo.Namespace}); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0)
+	for i := range pipelineList.Items {
+		pipeline := &pipelineList.Items[i]
+		if !pipeline.Spec.Enabled || pipeline.Spec.RepositoryEmbeddings == nil || pipeline.Spec.RepositoryEmbeddings.Repository.Name != repo.Name {
+			continue
+		}
+
+		execution := &v1alpha1.PipelineExecution{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "pipeline-execution-",
+				Namespace:    pipeline.Namespace,
+				Labels: map[string]string{
+					"pipelineId": pipeline.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(pipeline, v1alpha1.GroupVersion.WithKind("Pipeline")),
+				},
+			},
+			Spec: v1alpha1.PipelineExecutionSpec{
+				PipelineRef: corev1.ObjectReference{
+					Name:      pipeline.Name,
+					Namespace: pipeline.Namespace,
+				},
+				Metadata: map[string]string{
+					"trigger": "github-push",
+					"ref":     payload.Ref,
+					"commit":  payload.After,
+				},
+			},
+		}
+		if name := fmt.Sprintf("%s-%s", pipeline.Name, strings.ToLower(delivery)); delivery != "" && len(validation.IsDNS1123Label(name)) == 0 {
+			execution.GenerateName = ""
+			execution.Name = name
+		}
+
+		if err := c.Create(r.Context(), execution); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				continue
+			}
+			return nil, err
+		}
+		names = append(names, execution.Name)
+	}
+	return names, nil
+}
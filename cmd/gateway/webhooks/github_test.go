@@ -0,0 +1,120 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func sign(key, body []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifySignature(t *testing.T) {
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	secret := []byte("s3cr3t")
+	secretOf := func(name string, data map[string][]byte) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}, Data: data}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		secretOf("signed", map[string][]byte{v1alpha1.WebhookSecretKey: secret}),
+		secretOf("empty", map[string][]byte{v1alpha1.WebhookSecretKey: {}}),
+		secretOf("token-only", map[string][]byte{"token": []byte("ghp_token")}),
+	).Build()
+
+	tests := []struct {
+		name       string
+		repository string
+		signature  string
+		want       bool
+	}{
+		{name: "valid signature", repository: "signed", signature: sign(secret, body), want: true},
+		{name: "upper case hex", repository: "signed", signature: "sha256=" + strings.ToUpper(sign(secret, body)[len("sha256="):]), want: true},
+		{name: "signed with another secret", repository: "signed", signature: sign([]byte("other"), body), want: false},
+		{name: "signature of another payload", repository: "signed", signature: sign(secret, []byte(`{}`)), want: false},
+		{name: "sha1 signature", repository: "signed", signature: "sha1=" + sign(secret, body)[len("sha256="):], want: false},
+		{name: "invalid hex", repository: "signed", signature: "sha256=not-hex", want: false},
+		{name: "missing signature", repository: "signed", signature: "", want: false},
+		{name: "empty webhook secret", repository: "empty", signature: sign([]byte{}, body), want: false},
+		{name: "secret without webhook secret", repository: "token-only", signature: sign(secret, body), want: false},
+		{name: "repository without secret", repository: "unknown", signature: sign(secret, body), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &v1alpha1.Repository{ObjectMeta: metav1.ObjectMeta{Name: tt.repository, Namespace: "default"}}
+			r := httptest.NewRequest("POST", "/webhooks/github", nil)
+			got, err := verifySignature(r, c, repo, body, tt.signature)
+			if err != nil {
+				t.Fatalf("verifySignature() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("verifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitHubRequiresSignature(t *testing.T) {
+	secret := []byte("s3cr3t")
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = v1alpha1.AddToScheme(s)
+	c := fake.NewClientBuilder().WithScheme(s).WithObjects(
+		&v1alpha1.Repository{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: "default"},
+			Spec: v1alpha1.RepositorySpec{
+				Type:   v1alpha1.RepositoryTypeGithub,
+				Github: &v1alpha1.GithubRepositorySpec{Owner: "encoder-run", Name: "operator", URL: "github.com/encoder-run/operator"},
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "operator", Namespace: "default"},
+			Data:       map[string][]byte{v1alpha1.WebhookSecretKey: secret},
+		},
+	).Build()
+
+	registered := `{"repository":{"full_name":"encoder-run/operator","clone_url":"https://github.com/encoder-run/operator.git"}}`
+	unknown := `{"repository":{"full_name":"encoder-run/other","clone_url":"https://github.com/encoder-run/other.git"}}`
+	tests := []struct {
+		name      string
+		payload   string
+		signature string
+		want      int
+	}{
+		{name: "signed ping of a registered repository", payload: registered, signature: sign(secret, []byte(registered)), want: http.StatusOK},
+		{name: "unsigned ping of a registered repository", payload: registered, want: http.StatusUnauthorized},
+		{name: "ping of a registered repository signed with another secret", payload: registered, signature: sign([]byte("other"), []byte(registered)), want: http.StatusUnauthorized},
+		{name: "unsigned ping of an unknown repository", payload: unknown, want: http.StatusUnauthorized},
+		{name: "signed ping of an unknown repository", payload: unknown, signature: sign(secret, []byte(unknown)), want: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/webhooks/github", strings.NewReader(tt.payload))
+			r = r.WithContext(context.WithValue(r.Context(), common.AdminClientKey, c))
+			r.Header.Set("X-GitHub-Event", "ping")
+			if tt.signature != "" {
+				r.Header.Set("X-Hub-Signature-256", tt.signature)
+			}
+			w := httptest.NewRecorder()
+			GitHub(w, r)
+			if w.Code != tt.want {
+				t.Errorf("GitHub() status = %d, want %d: %s", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	// Embed the time zone database for the time zones of pipeline schedules.
+	_ "time/tzdata"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
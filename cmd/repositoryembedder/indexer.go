@@ -0,0 +1,256 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"log"
+	"strconv"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/chunker"
+	"github.com/encoder-run/operator/pkg/embedder"
+	"github.com/encoder-run/operator/pkg/vectorstore"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// batchSize is the number of files embedded at once.
+const batchSize = 10
+
+// indexer embeds files in batches and records the refs every file is in. Files that
+// are already embedded for another ref only get the ref added, so identical files
+// are embedded once across refs.
+type indexer struct {
+	repo      *git.Repository
+	embClient *embedder.EmbeddingClient
+	ch        chunker.Chunker
+	store     vectorstore.VectorStore
+	dimension int
+
+	batch []embedder.CodeEmbeddingRequest
+	// refs of the files of the batch, keyed by fileKey
+	refs map[string][]string
+	// removed are applied once all files are embedded so that modified files stay searchable
+	removed []removedRef
+
+	// stats are reported on the pipeline execution, the files scanned are counted by
+	// the callers
+	stats v1alpha1.ExecutionStats
+	// progress is reported periodically on the pipeline execution if the reporter is set
+	progress v1alpha1.ExecutionProgress
+	reporter *progressReporter
+
+	// shard is the index of the shard of the paths embedded if the files are embedded
+	// in more than one shard
+	shard  int
+	shards int
+}
+
+// removedRef is a ref removed from a file. The embeddings of the file are deleted if
+// the ref is empty.
+type removedRef struct {
+	file vectorstore.IndexedFile
+	ref  string
+}
+
+func newIndexer(repo *git.Repository, embClient *embedder.EmbeddingClient, ch chunker.Chunker, store vectorstore.VectorStore, dimension int) *indexer {
+	return &indexer{
+		repo:      repo,
+		embClient: embClient,
+		ch:        ch,
+		store:     store,
+		dimension: dimension,
+		refs:      make(map[string][]string),
+	}
+}
+
+func fileKey(hash, path string) string {
+	return hash + ":" + path
+}
+
+// owns returns true if the path is in the shard of the indexer. Paths are assigned to
+// shards by their hash, so every shard of an execution assigns them the same way.
+func (ix *indexer) owns(path string) bool {
+	if ix.shards <= 1 {
+		return true
+	}
+	h := fnv.New32a()
+	h.Write([]byte(path))
+	return int(h.Sum32()%uint32(ix.shards)) == ix.shard
+}
+
+// shardKey returns the key of the shard in the status of the execution, or an empty
+// key if the files are not sharded.
+func (ix *indexer) shardKey() string {
+	if ix.shards <= 1 {
+		return ""
+	}
+	return strconv.Itoa(ix.shard)
+}
+
+// addTotal adds files to process to the progress.
+func (ix *indexer) addTotal(files int) {
+	ix.progress.FilesTotal += int64(files)
+}
+
+// processed counts a processed file and reports the progress. The embedder stops here
+// when interrupted, between files, so every saved batch is complete. The indexed commits
+// are not recorded then and the next execution indexes the changes again.
+func (ix *indexer) processed() {
+	if interrupted.Load() {
+		ix.reportProgress()
+		log.Fatal("interrupted before all files were indexed, the changes are indexed again by the next execution")
+	}
+	ix.progress.FilesProcessed++
+	ix.reporter.report(ix.progress, false)
+}
+
+// reportProgress reports the progress regardless of the time of the last report.
+func (ix *indexer) reportProgress() {
+	ix.reporter.report(ix.progress, true)
+}
+
+// embed adds the file to the batch, the embeddings are saved with the refs.
+func (ix *indexer) embed(hash plumbing.Hash, path, language string, refs []string) {
+	blob, err := ix.repo.BlobObject(hash)
+	if err != nil {
+		log.Fatal(err)
+	}
+	reader, err := blob.Reader()
+	if err != nil {
+		log.Fatal(err)
+	}
+	content, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ix.batch = append(ix.batch, embedder.CodeEmbeddingRequest{
+		Path:     path,
+		Content:  string(content),
+		Hash:     hash.String(),
+		Language: language,
+	})
+	ix.refs[fileKey(hash.String(), path)] = refs
+	ix.stats.FilesEmbedded++
+	if len(ix.batch) >= batchSize {
+		ix.flush()
+	}
+}
+
+// addRef adds the ref to the file and embeds the file if it has no embeddings yet.
+func (ix *indexer) addRef(hash plumbing.Hash, path, language, ref string) {
+	key := fileKey(hash.String(), path)
+	if refs, ok := ix.refs[key]; ok {
+		if !containsRef(refs, ref) {
+			ix.refs[key] = append(refs, ref)
+		}
+		return
+	}
+
+	found, err := ix.store.AddFileRef(context.TODO(), hash.String(), path, ref)
+	if err != nil {
+		log.Fatalf("failed to add ref %s to the embeddings of '%s': %v", ref, path, err)
+	}
+	if found {
+		fmt.Printf("Skipping file '%s' since its hash is already processed\n", path)
+		return
+	}
+	ix.embed(hash, path, language, []string{ref})
+}
+
+// removeRef removes the ref from the file once all files are embedded.
+func (ix *indexer) removeRef(hash, path, ref string) {
+	ix.removed = append(ix.removed, removedRef{file: vectorstore.IndexedFile{Hash: hash, Path: path}, ref: ref})
+}
+
+// deleteFile deletes the embeddings of the file once all files are embedded.
+func (ix *indexer) deleteFile(hash, path string) {
+	ix.removed = append(ix.removed, removedRef{file: vectorstore.IndexedFile{Hash: hash, Path: path}})
+}
+
+// finish embeds the remaining files of the batch and applies the removed refs.
+func (ix *indexer) finish() {
+	ix.flush()
+	ix.stats.FilesDeleted += int64(len(ix.removed))
+	for _, r := range ix.removed {
+		if r.ref == "" {
+			fmt.Printf("Deleting embeddings of file '%s' with hash %s since it is no longer in the tree\n", r.file.Path, r.file.Hash)
+			if err := ix.store.DeleteFile(context.TODO(), r.file.Hash, r.file.Path); err != nil {
+				log.Fatalf("failed to delete stale embeddings: %v", err)
+			}
+			continue
+		}
+		fmt.Printf("Removing ref %s from file '%s' with hash %s since it is no longer in the tree\n", r.ref, r.file.Path, r.file.Hash)
+		if err := ix.store.RemoveFileRef(context.TODO(), r.file.Hash, r.file.Path, r.ref); err != nil {
+			log.Fatalf("failed to remove stale refs: %v", err)
+		}
+	}
+	ix.removed = nil
+}
+
+// flush embeds the files of the batch and saves their embeddings.
+func (ix *indexer) flush() {
+	if len(ix.batch) == 0 {
+		return
+	}
+
+	var embeddings *embedder.CodeEmbeddingsResponse
+	var err error
+	if ix.ch != nil {
+		embeddings, err = ix.embClient.FetchChunkEmbeddings(ix.batch, ix.ch)
+	} else {
+		embeddings, err = ix.embClient.FetchEmbeddings(ix.batch)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	chunks := make([]vectorstore.Chunk, 0)
+	for filePath, embs := range embeddings.Results {
+		for _, emb := range embs.Embeddings {
+			// The storage indices are created with the dimension of the pipeline.
+			if len(emb.Embedding) != ix.dimension {
+				log.Fatalf("model returned embeddings of dimension %d for %s but the pipeline expects %d, update the dimension of the model", len(emb.Embedding), filePath, ix.dimension)
+			}
+			chunks = append(chunks, vectorstore.Chunk{
+				FileHash:   emb.FileHash,
+				FilePath:   filePath,
+				ChunkID:    emb.ChunkID,
+				StartIndex: emb.StartIndex,
+				EndIndex:   emb.EndIndex,
+				Embedding:  emb.Embedding,
+				Symbol:     emb.Symbol,
+				Refs:       ix.refs[fileKey(emb.FileHash, filePath)],
+			})
+		}
+	}
+	if err := ix.store.UpsertChunks(context.TODO(), chunks); err != nil {
+		log.Fatalf("failed to save or update embeddings: %v", err)
+	}
+	ix.stats.ChunksWritten += int64(len(chunks))
+	ix.progress.Batch++
+
+	ix.batch = nil
+	ix.refs = make(map[string][]string)
+}
+
+// executionStats returns the stats of the indexed files.
+func (ix *indexer) executionStats() *v1alpha1.ExecutionStats {
+	stats := ix.stats
+	if stats.FilesSkipped = stats.FilesScanned - stats.FilesEmbedded; stats.FilesSkipped < 0 {
+		stats.FilesSkipped = 0
+	}
+	return &stats
+}
+
+func containsRef(refs []string, ref string) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
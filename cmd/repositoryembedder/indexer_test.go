@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/storage/memory"
+
+	"github.com/encoder-run/operator/pkg/vectorstore"
+)
+
+// fakeStore keeps the refs of the embedded files in memory.
+type fakeStore struct {
+	vectorstore.VectorStore
+	files map[string]*vectorstore.IndexedFile
+	// embedded are the keys of the files in the order they were embedded
+	embedded []string
+}
+
+func newFakeStore(files ...vectorstore.IndexedFile) *fakeStore {
+	s := &fakeStore{files: make(map[string]*vectorstore.IndexedFile)}
+	for i := range files {
+		s.files[fileKey(files[i].Hash, files[i].Path)] = &files[i]
+	}
+	return s
+}
+
+func (s *fakeStore) UpsertChunks(ctx context.Context, chunks []vectorstore.Chunk) error {
+	for _, c := range chunks {
+		key := fileKey(c.FileHash, c.FilePath)
+		if c.ChunkID == 0 {
+			s.embedded = append(s.embedded, key)
+		}
+		s.files[key] = &vectorstore.IndexedFile{Hash: c.FileHash, Path: c.FilePath, Refs: c.Refs}
+	}
+	return nil
+}
+
+func (s *fakeStore) DeleteFile(ctx context.Context, fileHash, filePath string) error {
+	delete(s.files, fileKey(fileHash, filePath))
+	return nil
+}
+
+func (s *fakeStore) AddFileRef(ctx context.Context, fileHash, filePath, ref string) (bool, error) {
+	f, ok := s.files[fileKey(fileHash, filePath)]
+	if !ok {
+		return false, nil
+	}
+	if !containsRef(f.Refs, ref) {
+		f.Refs = append(f.Refs, ref)
+	}
+	return true, nil
+}
+
+func (s *fakeStore) RemoveFileRef(ctx context.Context, fileHash, filePath, ref string) error {
+	key := fileKey(fileHash, filePath)
+	f, ok := s.files[key]
+	if !ok {
+		return nil
+	}
+	refs := make([]string, 0, len(f.Refs))
+	for _, r := range f.Refs {
+		if r != ref {
+			refs = append(refs, r)
+		}
+	}
+	if len(refs) == 0 {
+		delete(s.files, key)
+		return nil
+	}
+	f.Refs = refs
+	return nil
+}
+
+func (s *fakeStore) IndexedFiles(ctx context.Context) ([]vectorstore.IndexedFile, error) {
+	files := make([]vectorstore.IndexedFile, 0, len(s.files))
+	for _, f := range s.files {
+		files = append(files, *f)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return fileKey(files[i].Hash, files[i].Path) < fileKey(files[j].Hash, files[j].Path)
+	})
+	return files, nil
+}
+
+// refs returns the sorted refs of every file of the store by path.
+func (s *fakeStore) refs() map[string][]string {
+	refs := make(map[string][]string, len(s.files))
+	for _, f := range s.files {
+		fileRefs := append([]string{}, f.Refs...)
+		sort.Strings(fileRefs)
+		refs[f.Path] = fileRefs
+	}
+	return refs
+}
+
+func TestIndexerAddRef(t *testing.T) {
+	st := memory.NewStorage()
+	r, err := git.Init(st, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := buildTree(t, st, map[string]testFile{
+		"main.go":    {content: "package main\n"},
+		"indexed.go": {content: "package indexed\n"},
+	})
+	mainFile, err := tree.File("main.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexedFile, err := tree.File("indexed.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := newFakeStore(vectorstore.IndexedFile{Hash: indexedFile.Hash.String(), Path: "indexed.go", Refs: []string{"refs/heads/main"}})
+	ix := newIndexer(r, embeddingServer(t), nil, store, 1)
+	// The file of both refs is embedded once with both refs.
+	ix.addRef(mainFile.Hash, "main.go", "go", "refs/heads/main")
+	ix.addRef(mainFile.Hash, "main.go", "go", "refs/heads/dev")
+	ix.addRef(mainFile.Hash, "main.go", "go", "refs/heads/dev")
+	// The embedded file only gets the ref.
+	ix.addRef(indexedFile.Hash, "indexed.go", "go", "refs/heads/dev")
+	ix.finish()
+
+	if want := []string{fileKey(mainFile.Hash.String(), "main.go")}; !reflect.DeepEqual(store.embedded, want) {
+		t.Errorf("addRef() embedded %v, want %v", store.embedded, want)
+	}
+	want := map[string][]string{
+		"main.go":    {"refs/heads/dev", "refs/heads/main"},
+		"indexed.go": {"refs/heads/dev", "refs/heads/main"},
+	}
+	if got := store.refs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("addRef() refs = %v, want %v", got, want)
+	}
+}
+
+func TestIndexerFinish(t *testing.T) {
+	store := newFakeStore(
+		vectorstore.IndexedFile{Hash: "a", Path: "shared.go", Refs: []string{"refs/heads/main", "refs/heads/dev"}},
+		vectorstore.IndexedFile{Hash: "b", Path: "main.go", Refs: []string{"refs/heads/main"}},
+		vectorstore.IndexedFile{Hash: "c", Path: "legacy.go"},
+	)
+	ix := newIndexer(nil, nil, nil, store, 1)
+	ix.removeRef("a", "shared.go", "refs/heads/main")
+	ix.removeRef("b", "main.go", "refs/heads/main")
+	ix.deleteFile("c", "legacy.go")
+	if len(store.files) != 3 {
+		t.Fatalf("removed refs were applied before finish()")
+	}
+	ix.finish()
+
+	// Files left without refs are deleted.
+	want := map[string][]string{"shared.go": {"refs/heads/dev"}}
+	if got := store.refs(); !reflect.DeepEqual(got, want) {
+		t.Errorf("finish() refs = %v, want %v", got, want)
+	}
+}
+
+func TestIndexerOwns(t *testing.T) {
+	paths := []string{"README.md", "go.mod", "main.go", "cmd/gateway/main.go", "pkg/chunker/syntax.go", "docs/a b.md", "ünïcode.py"}
+	for i := 0; i < 500; i++ {
+		paths = append(paths, fmt.Sprintf("pkg/module%d/file%d.go", i%17, i))
+	}
+
+	tests := []struct {
+		name   string
+		shards int
+	}{
+		{name: "not sharded", shards: 0},
+		{name: "single shard", shards: 1},
+		{name: "two shards", shards: 2},
+		{name: "seven shards", shards: 7},
+		{name: "maximum shards", shards: 32},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shards := tt.shards
+			if shards < 1 {
+				shards = 1
+			}
+			counts := make([]int, shards)
+			for _, path := range paths {
+				owners := 0
+				for shard := 0; shard < shards; shard++ {
+					ix := &indexer{shard: shard, shards: tt.shards}
+					if ix.owns(path) {
+						owners++
+						counts[shard]++
+					}
+				}
+				if owners != 1 {
+					t.Errorf("%q is owned by %d of %d shards, want 1", path, owners, shards)
+				}
+			}
+			// Every shard gets some of the paths, so no pod idles.
+			for shard, count := range counts {
+				if count == 0 {
+					t.Errorf("shard %d of %d owns none of the %d paths", shard, shards, len(paths))
+				}
+			}
+		})
+	}
+}
+
+func TestIndexerShardKey(t *testing.T) {
+	tests := []struct {
+		shard, shards int
+		want          string
+	}{
+		{shard: 0, shards: 0, want: ""},
+		{shard: 0, shards: 1, want: ""},
+		{shard: 0, shards: 4, want: "0"},
+		{shard: 3, shards: 4, want: "3"},
+	}
+	for _, tt := range tests {
+		ix := &indexer{shard: tt.shard, shards: tt.shards}
+		if got := ix.shardKey(); got != tt.want {
+			t.Errorf("shardKey() of shard %d of %d = %q, want %q", tt.shard, tt.shards, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+)
+
+// interrupted is set once the embedder is asked to stop, e.g. when its execution is
+// cancelled or its pod is evicted.
+var interrupted atomic.Bool
+
+// handleInterrupts sets interrupted on SIGTERM and SIGINT instead of exiting right
+// away, so the batch being saved is saved completely.
+func handleInterrupts() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, os.Interrupt)
+	go func() {
+		<-signals
+		fmt.Println("Received a termination signal, stopping once the current batch is saved")
+		interrupted.Store(true)
+	}()
+}
@@ -1,9 +1,8 @@
 package main
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
@@ -12,26 +11,25 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/RediSearch/redisearch-go/v2/redisearch"
 	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
-	postgrescache "github.com/encoder-run/operator/pkg/cache/postgres"
-	rediscache "github.com/encoder-run/operator/pkg/cache/redis"
-	"github.com/encoder-run/operator/pkg/database"
+	"github.com/encoder-run/operator/pkg/chunker"
 	"github.com/encoder-run/operator/pkg/embedder"
+	"github.com/encoder-run/operator/pkg/vectorstore"
 	"github.com/go-git/go-git/v5" // with go modules enabled (GO111MODULE=on or outside GOPATH)
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
 	"github.com/go-git/go-git/v5/storage"
-	redigoredis "github.com/gomodule/redigo/redis"
-	"github.com/pgvector/pgvector-go"
+	"github.com/go-git/go-git/v5/utils/merkletrie"
 	"github.com/pkg/errors"
-	"github.com/redis/go-redis/v9"
-	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,67 +37,109 @@ import (
 
 // supportedLanguages is a map of extension to language. If an item is in the map we should process it.
 var supportedLanguages = map[string]string{
-	".py":    "python",
-	".go":    "go",
-	".js":    "javascript",
-	".jsx":   "javascript",
-	".ts":    "typescript",
-	".tsx":   "typescript",
-	".rb":    "ruby",
-	".java":  "java",
-	".c":     "c",
-	".cpp":   "cpp",
-	".h":     "c",
-	".hpp":   "cpp",
-	".cs":    "csharp",
-	".php":   "php",
-	".rs":    "rust",
-	".swift": "swift",
-	".kt":    "kotlin",
-	".kts":   "kotlin",
-	".clj":   "clojure",
-	".cljs":  "clojurescript",
-	".scala": "scala",
-	".r":     "r",
-	".m":     "matlab",
-	".jl":    "julia",
-	".pl":    "perl",
-	".sh":    "shell",
-	".bash":  "shell",
-	".bat":   "shell",
-	".txt":   "plaintext",
-	".md":    "markdown",
-	".html":  "html",
-	".css":   "css",
-	".yaml":  "yaml",
-	".yml":   "yaml",
-	".graphql": "graphql",
+	".py":       "python",
+	".go":       "go",
+	".js":       "javascript",
+	".jsx":      "javascript",
+	".ts":       "typescript",
+	".tsx":      "tsx",
+	".rb":       "ruby",
+	".java":     "java",
+	".c":        "c",
+	".cpp":      "cpp",
+	".h":        "c",
+	".hpp":      "cpp",
+	".cs":       "csharp",
+	".php":      "php",
+	".rs":       "rust",
+	".swift":    "swift",
+	".kt":       "kotlin",
+	".kts":      "kotlin",
+	".clj":      "clojure",
+	".cljs":     "clojurescript",
+	".scala":    "scala",
+	".r":        "r",
+	".m":        "matlab",
+	".jl":       "julia",
+	".pl":       "perl",
+	".sh":       "shell",
+	".bash":     "shell",
+	".bat":      "shell",
+	".txt":      "plaintext",
+	".md":       "markdown",
+	".html":     "html",
+	".css":      "css",
+	".yaml":     "yaml",
+	".yml":      "yaml",
+	".graphql":  "graphql",
 	".graphqls": "graphql",
 }
 
 func main() {
 	// Define flags
+	var pipelineId string
+	var executionId string
 	var storageId string
 	var repositoryId string
 	var modelId string
-
+	var dimension int
+	var chunking string
+	var chunkSize int
+	var chunkOverlap int
+	var shard int
+	var shards int
+	var finalize bool
+
+	flag.StringVar(&pipelineId, "pipelineId", "", "Pipeline ID, the whole tree is indexed on every run if empty")
+	flag.StringVar(&executionId, "executionId", "", "Pipeline execution ID, records the indexed commits if set")
 	flag.StringVar(&storageId, "storageId", "", "Storage ID")
 	flag.StringVar(&repositoryId, "repositoryId", "", "Repository ID")
 	flag.StringVar(&modelId, "modelId", "", "Model ID")
+	flag.IntVar(&dimension, "dimension", v1alpha1.DefaultEmbeddingDimension, "Dimension of the embeddings")
+	flag.StringVar(&chunking, "chunking", "", "Chunking strategy (TOKENS, LINES, LANGUAGE or SYNTAX), the model chunks the files if empty")
+	flag.IntVar(&chunkSize, "chunkSize", 0, "Size of the chunks, defaults to the default of the chunking strategy")
+	flag.IntVar(&chunkOverlap, "chunkOverlap", 0, "Overlap of consecutive chunks, defaults to the default of the chunking strategy")
+	flag.IntVar(&shard, "shard", 0, "Index of the shard of the paths embedded, starting at 0")
+	flag.IntVar(&shards, "shards", 1, "Number of shards the paths are embedded in, the indexed commits are recorded by the finalize run if greater than 1")
+	flag.BoolVar(&finalize, "finalize", false, "Record the indexed commits once all shards were embedded, no files are embedded")
 
 	// Parse flags
 	flag.Parse()
 
+	// The last log message is the failure message of the execution.
+	log.SetOutput(io.MultiWriter(os.Stderr, terminationLog{}))
+	handleInterrupts()
+
 	// Example usage of flags in the application logic
 	fmt.Printf("Using storage ID: %s\n", storageId)
 	fmt.Printf("Using repository ID: %s\n", repositoryId)
 	fmt.Printf("Using model ID: %s\n", modelId)
+	fmt.Printf("Using embedding dimension: %d\n", dimension)
 
 	// Check if all required arguments are provided
 	if storageId == "" || repositoryId == "" || modelId == "" {
 		Warning("All arguments (storageId, repositoryId, modelId) are required.")
 		os.Exit(1)
 	}
+	if shards < 1 || shard < 0 || shard >= shards {
+		Warning("The shard must be between 0 and the number of shards.")
+		os.Exit(1)
+	}
+	sharded := shards > 1 && !finalize
+	if sharded {
+		fmt.Printf("Embedding shard %d of %d\n", shard, shards)
+	}
+
+	// Get the chunker, the files are sent whole to the model if it isn't set.
+	var ch chunker.Chunker
+	if chunking != "" {
+		fmt.Printf("Using chunking strategy: %s\n", chunking)
+		strategyChunker, err := chunker.New(chunker.Strategy(chunking), chunkSize, chunkOverlap)
+		if err != nil {
+			CheckIfError(err)
+		}
+		ch = strategyChunker
+	}
 
 	// Get the kubernetes client.
 	c, err := defaultClient()
@@ -114,6 +154,16 @@ func main() {
 		CheckIfError(err)
 	}
 
+	// Get the pipeline by name, it records the last indexed commit.
+	var pipeline *v1alpha1.Pipeline
+	if pipelineId != "" {
+		fmt.Printf("Using pipeline ID: %s\n", pipelineId)
+		pipeline = &v1alpha1.Pipeline{}
+		if err := c.Get(context.TODO(), client.ObjectKey{Name: pipelineId, Namespace: ns}, pipeline); err != nil {
+			CheckIfError(err)
+		}
+	}
+
 	// Get the repository by name.
 	repo := &v1alpha1.Repository{}
 	if err := c.Get(context.TODO(), client.ObjectKey{Name: repositoryId, Namespace: ns}, repo); err != nil {
@@ -125,139 +175,242 @@ func main() {
 	if err := c.Get(context.TODO(), client.ObjectKey{Name: storageId, Namespace: ns}, st); err != nil {
 		CheckIfError(err)
 	}
+	// Get the model by name.
+	model := &v1alpha1.Model{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Name: modelId, Namespace: ns}, model); err != nil {
+		CheckIfError(err)
+	}
 	// Initialize embClient
-	embClient := embedder.NewClient(modelId, ns)
+	embClient, err := embedder.NewModelClient(context.TODO(), c, model)
+	if err != nil {
+		CheckIfError(err)
+	}
 
-	// Repository URL for remote git repository
+	// Repository URL for remote git repository. The url without scheme is used as the
+	// namespace for everything stored about the repository.
 	var url string
-	var branch string
+	var cloneURL string
 	switch repo.Spec.Type {
 	case v1alpha1.RepositoryTypeGithub:
 		url = repo.Spec.Github.URL
-		branch = repo.Spec.Github.Branch
+		cloneURL = fmt.Sprintf("https://%s", url)
+	case v1alpha1.RepositoryTypeGitlab:
+		url = repo.Spec.Gitlab.URL
+		cloneURL = fmt.Sprintf("%s/%s.git", strings.TrimSuffix(repo.Spec.Gitlab.BaseURL, "/"), repo.Spec.Gitlab.ProjectPath)
+	case v1alpha1.RepositoryTypeBitbucket:
+		url = repo.Spec.Bitbucket.URL
+		cloneURL = fmt.Sprintf("https://%s.git", url)
+	case v1alpha1.RepositoryTypeGit:
+		url = repo.Spec.Git.URL
+		cloneURL = repo.Spec.Git.CloneURL
 	default:
 		CheckIfError(fmt.Errorf("unsupported repository type: %s", repo.Spec.Type))
 	}
 
-	var storer storage.Storer
-	var redisearchClient *redisearch.Client
-	var redisClient *redis.Client
-	var db *gorm.DB
-	switch st.Spec.Type {
-	case v1alpha1.StorageTypeRedis:
-		// Get the go-git storage storer based on the storage type
-		s, opts, err := redisStorageStorer(c, st, url)
-		if err != nil {
-			CheckIfError(err)
-		}
-		storer = s
-		redisearchClient = getRedisearchClient(opts, fmt.Sprintf("%s:embedding", url))
-		redisClient = redis.NewClient(opts)
-		err = createIndex(redisearchClient, url)
-		if err != nil {
-			CheckIfError(err)
-		}
-	case v1alpha1.StorageTypePostgres:
-		// Get the go-git storage storer based on the storage type
-		s, dbClient, err := postgresStorageStorer(c, st, url)
-		if err != nil {
-			CheckIfError(err)
-		}
-		db = dbClient
-		storer = s
-	default:
-		CheckIfError(fmt.Errorf("unsupported storage type: %s", st.Spec.Type))
+	// Get the vector store of the repository in the storage.
+	store, err := vectorstore.New(context.TODO(), c, st, url, dimension)
+	if err != nil {
+		CheckIfError(err)
 	}
+	defer store.Close()
+	if err := store.Init(context.TODO()); err != nil {
+		CheckIfError(err)
+	}
+	storer := store.Storer()
+
 	// auth gets the go-git auth created.
 	auth, err := gitAuth(c, repo)
 	if err != nil {
 		CheckIfError(err)
 	}
 
-	r, err := git.Open(storer, nil)
-	if err != nil {
-		if err == git.ErrRepositoryNotExists {
-			r, err = git.Clone(storer, nil, &git.CloneOptions{
-				URL:           fmt.Sprintf("https://%s", url),
-				Auth:          auth,
-				ReferenceName: plumbing.NewBranchReferenceName(branch),
-				Depth:         1,
-				SingleBranch:  true,
-			})
-			CheckIfError(err)
-		} else {
-			CheckIfError(err)
-		}
+	r, err := openRepository(storer, cloneURL)
+	CheckIfError(err)
+
+	// Resolve the refs of the repository against the branches and tags of the remote.
+	remote, err := r.Remote("origin")
+	CheckIfError(err)
+	remoteRefs, err := remote.List(&git.ListOptions{Auth: auth})
+	CheckIfError(err)
+	refNames, err := resolveRefs(remoteRefs, repo.Spec.IndexedRefs())
+	CheckIfError(err)
+	if len(refNames) == 0 {
+		CheckIfError(fmt.Errorf("no branches or tags of the remote match the refs %v", repo.Spec.IndexedRefs()))
 	}
+
 	// Fetch changes from the remote repository
-	refSpec := fmt.Sprintf("+refs/heads/%s:refs/remotes/origin/%s", branch, branch)
+	refSpecs := make([]config.RefSpec, 0, len(refNames))
+	for _, name := range refNames {
+		refSpecs = append(refSpecs, fetchRefSpec(name))
+	}
 	err = r.Fetch(&git.FetchOptions{
 		RemoteName: "origin",
-		RefSpecs: []config.RefSpec{
-			config.RefSpec(refSpec),
-		},
-		Auth:  auth,
-		Depth: 1,
+		RefSpecs:   refSpecs,
+		Auth:       auth,
+		Depth:      1,
+		Tags:       git.NoTags,
 	})
 	if err != git.NoErrAlreadyUpToDate {
 		CheckIfError(err)
 	}
 
-	// err = r.Prune(git.PruneOptions{})
-	// CheckIfError(err)
+	refs := make([]indexedRef, 0, len(refNames))
+	for _, name := range refNames {
+		commit, err := refCommit(r, name)
+		CheckIfError(err)
+		tree, err := commit.Tree()
+		CheckIfError(err)
+		fmt.Printf("Indexing ref %s at commit %s\n", name, commit.Hash)
+		refs = append(refs, indexedRef{name: name, commit: commit, tree: tree})
+	}
 
-	// Manually update local branch reference to match the remote tracking branch
-	// Typically in a bare repo, this might be done in response to a push or a hook
-	remoteRef, err := r.Reference(plumbing.NewRemoteReferenceName("origin", branch), true)
-	CheckIfError(err)
-	fmt.Printf("Remote Ref: %v\n", remoteRef)
+	// Only index the changes since the last indexed commits if they are still in the storage.
+	ix := newIndexer(r, embClient, ch, store, dimension)
+	if sharded {
+		ix.shard, ix.shards = shard, shards
+	}
+	if executionId != "" && !finalize {
+		ix.reporter = newProgressReporter(c, ns, executionId, ix.shardKey())
+	}
+	// The shards embedded the files already when finalizing.
+	if !finalize {
+		if lastTrees := lastIndexedTrees(r, pipeline, refs); lastTrees != nil {
+			for _, ref := range refs {
+				processChanges(ix, ref.name.String(), lastTrees[ref.name.String()], ref.tree)
+			}
+		} else {
+			processEmbeddings(ix, refs, ownedRefs(pipeline, refs))
+		}
+	}
+	ix.finish()
+	ix.reportProgress()
+
+	// The indexed commits are recorded by the finalize run once all shards succeeded.
+	if sharded {
+		if executionId != "" {
+			if err := recordShard(c, ns, executionId, ix.shardKey(), ix.executionStats()); err != nil {
+				log.Fatalf("failed to record the stats of the shard: %v", err)
+			}
+		}
+		return
+	}
 
-	// // Update local main directly to point to the same commit
-	// localRef := plumbing.NewHashReference(plumbing.NewBranchReferenceName(branch), remoteRef.Hash())
-	// err = r.Storer.SetReference(localRef)
-	// CheckIfError(err)
+	if pipeline != nil {
+		snapshots := updateSnapshots(ix, pipeline, refs)
+		if err := recordIndexedCommits(c, pipeline, refs, snapshots); err != nil {
+			log.Fatalf("failed to record the indexed commits: %v", err)
+		}
+	}
+	if executionId != "" {
+		if err := recordExecution(c, ns, executionId, refs, ix.executionStats()); err != nil {
+			log.Fatalf("failed to record the commits of the execution: %v", err)
+		}
+	}
+}
 
-	commit, err := r.CommitObject(remoteRef.Hash())
-	if err != nil {
-		log.Fatal(err)
+// indexedCommits returns the indexed commit of every ref.
+func indexedCommits(refs []indexedRef) []v1alpha1.IndexedCommit {
+	commits := make([]v1alpha1.IndexedCommit, 0, len(refs))
+	for _, ref := range refs {
+		commits = append(commits, v1alpha1.IndexedCommit{
+			Ref:    ref.name.String(),
+			Commit: ref.commit.Hash.String(),
+			Tree:   ref.tree.Hash.String(),
+		})
+	}
+	return commits
+}
+
+// recordExecution sets the indexed commits and the stats of the pipeline execution,
+// the stats of the shards of a sharded execution are added to the stats.
+func recordExecution(c client.Client, ns, executionId string, refs []indexedRef, stats *v1alpha1.ExecutionStats) error {
+	pe := &v1alpha1.PipelineExecution{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Name: executionId, Namespace: ns}, pe); err != nil {
+		return err
 	}
+	patch := client.MergeFrom(pe.DeepCopy())
+	for _, shardStats := range pe.Status.ShardStats {
+		stats.Add(shardStats)
+	}
+	pe.Status.Commits = indexedCommits(refs)
+	pe.Status.Stats = stats
+	return c.Status().Patch(context.TODO(), pe, patch)
+}
 
-	tree, err := commit.Tree()
+// recordShard sets the stats of the shard on the pipeline execution. Only the key of
+// the shard is patched since the shards record their stats concurrently.
+func recordShard(c client.Client, ns, executionId, shardKey string, stats *v1alpha1.ExecutionStats) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"shardStats": map[string]interface{}{shardKey: stats},
+		},
+	})
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
+	pe := &v1alpha1.PipelineExecution{ObjectMeta: metav1.ObjectMeta{Name: executionId, Namespace: ns}}
+	return c.Status().Patch(context.TODO(), pe, client.RawPatch(types.MergePatchType, patch))
+}
 
-	// Switch based on the db type
-	switch st.Spec.Type {
-	case v1alpha1.StorageTypeRedis:
-		processRedisEmbeddings(embClient, tree, redisClient, redisearchClient, url)
-	case v1alpha1.StorageTypePostgres:
-		processPostgresEmbeddings(embClient, tree, db, url)
-	default:
-		CheckIfError(fmt.Errorf("unsupported storage type: %s", st.Spec.Type))
+// updateSnapshots labels the files of the new indexed commits with their snapshot ref
+// and removes the snapshot refs of the commits beyond the number of snapshots to keep.
+// It returns the snapshots that are kept, newest first.
+func updateSnapshots(ix *indexer, pipeline *v1alpha1.Pipeline, refs []indexedRef) []v1alpha1.IndexedCommit {
+	keep := pipeline.Spec.RepositoryEmbeddings.Snapshots
+	existing := make(map[string]bool, len(pipeline.Status.Snapshots))
+	for _, s := range pipeline.Status.Snapshots {
+		existing[s.Commit] = true
+	}
+
+	snapshots := make([]v1alpha1.IndexedCommit, 0)
+	seen := make(map[string]bool)
+	if keep > 0 {
+		for i, commit := range indexedCommits(refs) {
+			if seen[commit.Commit] {
+				continue
+			}
+			seen[commit.Commit] = true
+			snapshots = append(snapshots, commit)
+			if !existing[commit.Commit] && len(snapshots) <= keep {
+				fmt.Printf("Creating snapshot of commit %s\n", commit.Commit)
+				labelTree(ix, refs[i].tree, vectorstore.SnapshotRef(commit.Commit))
+			}
+		}
 	}
-}
+	for _, s := range pipeline.Status.Snapshots {
+		if !seen[s.Commit] {
+			seen[s.Commit] = true
+			snapshots = append(snapshots, s)
+		}
+	}
+	ix.finish()
 
-func processPostgresEmbeddings(embClient *embedder.EmbeddingClient, tree *object.Tree, db *gorm.DB, url string) {
-	// Check for existing processed hashes
-	existingHashes := make(map[string]bool)
-	// List all rows in the codeembedding table given the url
-	var embeddings []database.CodeEmbedding
-	if err := db.Where("url = ?", url).Find(&embeddings).Error; err != nil {
+	if len(snapshots) <= keep {
+		return snapshots
+	}
+	pruned := make(map[string]bool)
+	for _, s := range snapshots[keep:] {
+		fmt.Printf("Deleting snapshot of commit %s\n", s.Commit)
+		pruned[vectorstore.SnapshotRef(s.Commit)] = true
+	}
+	indexedFiles, err := ix.store.IndexedFiles(context.TODO())
+	if err != nil {
 		log.Fatalf("failed to query existing embeddings: %v", err)
 	}
-
-	// Create a set of unique hashes
-	for _, embedding := range embeddings {
-		existingHashes[fmt.Sprintf("%s.%s", embedding.FileHash, embedding.FilePath)] = true
+	for _, f := range indexedFiles {
+		for _, ref := range f.Refs {
+			if pruned[ref] {
+				ix.removeRef(f.Hash, f.Path, ref)
+			}
+		}
 	}
+	ix.finish()
+	return snapshots[:keep]
+}
 
-	hashes := make(map[string]bool)
-	filesBatch := []embedder.CodeEmbeddingRequest{}
+// labelTree adds the ref to all supported files of the tree.
+func labelTree(ix *indexer, tree *object.Tree, ref string) {
 	treeIter := tree.Files()
-	batchSize := 10
-	count := 0
-
 	for {
 		file, err := treeIter.Next()
 		if err != nil {
@@ -266,184 +419,238 @@ func processPostgresEmbeddings(embClient *embedder.EmbeddingClient, tree *object
 			}
 			log.Fatal(err)
 		}
-
-		// Skip unsupported file types
-		ext := filepath.Ext(file.Name)
-		if _, ok := supportedLanguages[ext]; !ok {
-			fmt.Printf("Skipping file '%s' since it is not supported\n", file.Name)
-			continue
+		if language, ok := supportedLanguages[filepath.Ext(file.Name)]; ok {
+			ix.addRef(file.Hash, file.Name, language, ref)
 		}
+	}
+}
 
-		hashes[fmt.Sprintf("%s.%s", file.Hash.String(), file.Name)] = true
-
-		if !existingHashes[fmt.Sprintf("%s.%s", file.Hash.String(), file.Name)] {
-			content, err := file.Contents()
-			if err != nil {
-				log.Fatal(err)
-			}
-			filesBatch = append(filesBatch, embedder.CodeEmbeddingRequest{
-				Path:    file.Name,
-				Content: content,
-				Hash:    file.Hash.String(),
-			})
-			count++
-
-			if count >= batchSize {
-				processAndSaveEmbeddings(embClient, db, &filesBatch, url) // process embeddings
-				filesBatch = []embedder.CodeEmbeddingRequest{}            // Reset the batch
-				count = 0
-			}
-		} else {
-			fmt.Printf("Skipping file '%s' since its hash is already processed\n", file.Name)
-		}
+// lastIndexedTrees returns the trees of the commits indexed by the last execution of
+// the pipeline, keyed by ref. It returns nil if the refs changed since or any of the
+// commits is not in the storage, e.g. because the storage was recreated.
+func lastIndexedTrees(r *git.Repository, pipeline *v1alpha1.Pipeline, refs []indexedRef) map[string]*object.Tree {
+	if pipeline == nil || len(pipeline.Status.IndexedCommits) != len(refs) {
+		return nil
+	}
+	commits := make(map[string]string, len(pipeline.Status.IndexedCommits))
+	for _, indexed := range pipeline.Status.IndexedCommits {
+		commits[indexed.Ref] = indexed.Commit
 	}
 
-	if len(filesBatch) > 0 {
-		processAndSaveEmbeddings(embClient, db, &filesBatch, url) // Process any remaining files
+	trees := make(map[string]*object.Tree, len(refs))
+	for _, ref := range refs {
+		hash, ok := commits[ref.name.String()]
+		if !ok {
+			fmt.Printf("Indexing the whole trees since ref %s was not indexed by the last execution\n", ref.name)
+			return nil
+		}
+		commit, err := r.CommitObject(plumbing.NewHash(hash))
+		if err != nil {
+			fmt.Printf("Indexing the whole trees since the last indexed commit %s was not found: %v\n", hash, err)
+			return nil
+		}
+		tree, err := commit.Tree()
+		if err != nil {
+			fmt.Printf("Indexing the whole trees since the tree of the last indexed commit %s was not found: %v\n", hash, err)
+			return nil
+		}
+		fmt.Printf("Indexing the changes of ref %s since commit %s\n", ref.name, hash)
+		trees[ref.name.String()] = tree
 	}
+	return trees
 }
 
-func processAndSaveEmbeddings(embClient *embedder.EmbeddingClient, db *gorm.DB, filesBatch *[]embedder.CodeEmbeddingRequest, url string) {
-	embeddings, err := embClient.FetchEmbeddings(*filesBatch)
-	if err != nil {
-		log.Fatal(err)
-	}
-	for filePath, embs := range embeddings.Results {
-		for _, emb := range embs.Embeddings {
-			newEmb := database.CodeEmbedding{
-				URL:        url,
-				FileHash:   emb.FileHash,
-				FilePath:   filePath,
-				ChunkID:    emb.ChunkID,
-				StartIndex: emb.StartIndex,
-				EndIndex:   emb.EndIndex,
-				Embedding:  pgvector.NewVector(emb.Embedding), // Assuming emb.Vector is the appropriate data structure
-			}
-			// Upsert operation using Clauses with ON CONFLICT
-			if err := db.Clauses(clause.OnConflict{
-				Columns: []clause.Column{{Name: "chunk_id"}, {Name: "file_hash"}, {Name: "url"}, {Name: "file_path"}}, // Columns part of the unique constraint
-				DoUpdates: clause.Assignments(map[string]interface{}{ // Update these fields if there is a conflict
-					"start_index": newEmb.StartIndex,
-					"end_index":   newEmb.EndIndex,
-					"embedding":   newEmb.Embedding,
-				}),
-			}).Create(&newEmb).Error; err != nil {
-				log.Fatalf("failed to save or update embedding: %v", err)
-			}
+// ownedRefs returns the refs indexed by the pipeline now or by its last execution.
+// Other refs of the embeddings belong to other pipelines of the repository.
+func ownedRefs(pipeline *v1alpha1.Pipeline, refs []indexedRef) map[string]bool {
+	owned := make(map[string]bool)
+	for _, ref := range refs {
+		owned[ref.name.String()] = true
+	}
+	if pipeline != nil {
+		for _, indexed := range pipeline.Status.IndexedCommits {
+			owned[indexed.Ref] = true
 		}
 	}
+	return owned
 }
 
-func processRedisEmbeddings(embClient *embedder.EmbeddingClient, tree *object.Tree, redisClient *redis.Client, redisearchClient *redisearch.Client, url string) {
-	// Check for existing processed hashes
-	existingHashes := make(map[string]bool)
-	existingTreeHash, err := redisClient.Get(context.Background(), fmt.Sprintf("%s:embedding:tree", url)).Result()
-	if err != nil && err != redis.Nil {
-		log.Fatal(err)
+// recordIndexedCommits sets the indexed commits and snapshots of the pipeline.
+func recordIndexedCommits(c client.Client, pipeline *v1alpha1.Pipeline, refs []indexedRef, snapshots []v1alpha1.IndexedCommit) error {
+	patch := client.MergeFrom(pipeline.DeepCopy())
+	pipeline.Status.IndexedCommits = indexedCommits(refs)
+	pipeline.Status.Snapshots = snapshots
+	return c.Status().Patch(context.TODO(), pipeline, patch)
+}
+
+// processChanges adds the ref to the files added or modified between the trees and
+// removes it from the files modified or removed.
+func processChanges(ix *indexer, ref string, from, to *object.Tree) {
+	changes, err := object.DiffTree(from, to)
+	if err != nil {
+		log.Fatalf("failed to diff the trees: %v", err)
 	}
-	if err == nil {
-		existingHashesStr, err := redisClient.Get(context.Background(), fmt.Sprintf("%s:embedding:tree:%s", url, existingTreeHash)).Result()
-		if err != nil && err != redis.Nil {
-			log.Fatal(err)
-		}
-		for _, h := range strings.Split(existingHashesStr, ",") {
-			existingHashes[h] = true
+
+	// Changes are owned by the shards of their paths, a renamed file may be removed by
+	// one shard and added by another.
+	owned := make(object.Changes, 0, len(changes))
+	for _, change := range changes {
+		if ix.owns(change.From.Name) || ix.owns(change.To.Name) {
+			owned = append(owned, change)
 		}
 	}
 
-	hashes := make(map[string]bool)
-	filesBatch := []embedder.CodeEmbeddingRequest{}
-	treeIter := tree.Files()
-	batchSize := 10
-	count := 0
-
-	for {
-		fmt.Printf("Processing file\n")
-		file, err := treeIter.Next()
+	ix.addTotal(len(owned))
+	for _, change := range owned {
+		ix.processed()
+		action, err := change.Action()
 		if err != nil {
-			if err == io.EOF {
-				break // No more files
-			}
 			log.Fatal(err)
 		}
-
-		// Skip unsupported file types
-		ext := filepath.Ext(file.Name)
-		if _, ok := supportedLanguages[ext]; !ok {
-			fmt.Printf("Skipping file '%s' since it is not supported\n", file.Name)
+		// Changes of the file mode keep the content and its embeddings.
+		if action == merkletrie.Modify && change.From.TreeEntry.Hash == change.To.TreeEntry.Hash {
 			continue
 		}
 
-		hashes[file.Hash.String()] = true
+		if (action == merkletrie.Delete || action == merkletrie.Modify) && ix.owns(change.From.Name) {
+			if _, ok := supportedLanguages[filepath.Ext(change.From.Name)]; ok {
+				ix.removeRef(change.From.TreeEntry.Hash.String(), change.From.Name, ref)
+			}
+		}
+		if (action == merkletrie.Insert || action == merkletrie.Modify) && ix.owns(change.To.Name) {
+			language, ok := supportedLanguages[filepath.Ext(change.To.Name)]
+			if !ok {
+				fmt.Printf("Skipping file '%s' since it is not supported\n", change.To.Name)
+				continue
+			}
+			ix.stats.FilesScanned++
+			ix.addRef(change.To.TreeEntry.Hash, change.To.Name, language, ref)
+		}
+	}
+}
 
-		// Process only if the file hash is not in the existing hashes
-		if !existingHashes[file.Hash.String()] {
-			content, err := file.Contents()
+// treeFile is a supported file of the trees of the refs.
+type treeFile struct {
+	hash     plumbing.Hash
+	path     string
+	language string
+	refs     []string
+}
+
+// processEmbeddings indexes the whole trees of the refs. Files that are already
+// embedded only get their refs updated, and the owned refs are removed from the files
+// that are no longer in their tree. Files that are in no tree anymore are deleted.
+func processEmbeddings(ix *indexer, refs []indexedRef, owned map[string]bool) {
+	// Check for existing processed hashes
+	indexedFiles, err := ix.store.IndexedFiles(context.TODO())
+	if err != nil {
+		log.Fatalf("failed to query existing embeddings: %v", err)
+	}
+	indexed := make(map[string]vectorstore.IndexedFile, len(indexedFiles))
+	for _, f := range indexedFiles {
+		indexed[fileKey(f.Hash, f.Path)] = f
+	}
+
+	// Collect the refs of every file, identical files of different refs are embedded once.
+	files := make(map[string]*treeFile)
+	keys := make([]string, 0)
+	for _, ref := range refs {
+		treeIter := ref.tree.Files()
+		for {
+			file, err := treeIter.Next()
 			if err != nil {
+				if err == io.EOF {
+					break // No more files
+				}
 				log.Fatal(err)
 			}
 
-			filesBatch = append(filesBatch, embedder.CodeEmbeddingRequest{
-				Path:    file.Name,
-				Content: content,
-				Hash:    file.Hash.String(),
-			})
-			count++
-
-			// Process in batches of 10
-			if count >= batchSize {
-				fmt.Printf("Processing batch of files\n")
-				embeddings, err := embClient.FetchEmbeddings(filesBatch)
-				if err != nil {
-					log.Fatal(err)
-				}
-				fmt.Printf("Setting code embeddings\n")
-				err = setCodeEmbeddings(redisearchClient, *embeddings, url)
-				if err != nil {
-					log.Fatal(err)
-				}
-				// Reset the batch
-				filesBatch = []embedder.CodeEmbeddingRequest{}
-				count = 0
+			// Skip unsupported file types
+			language, ok := supportedLanguages[filepath.Ext(file.Name)]
+			if !ok {
+				fmt.Printf("Skipping file '%s' since it is not supported\n", file.Name)
+				continue
 			}
-		} else {
-			fmt.Printf("Skipping file '%s' since its hash is already processed\n", file.Name)
+			// The file is embedded by the shard of its path.
+			if !ix.owns(file.Name) {
+				continue
+			}
+
+			key := fileKey(file.Hash.String(), file.Name)
+			f, ok := files[key]
+			if !ok {
+				f = &treeFile{hash: file.Hash, path: file.Name, language: language}
+				files[key] = f
+				keys = append(keys, key)
+			}
+			f.refs = append(f.refs, ref.name.String())
 		}
 	}
 
-	// Process any remaining files
-	if len(filesBatch) > 0 {
-		fmt.Printf("Processing remaining files\n")
-		embeddings, err := embClient.FetchEmbeddings(filesBatch)
-		if err != nil {
-			log.Fatal(err)
+	ix.stats.FilesScanned += int64(len(keys))
+	ix.addTotal(len(keys))
+	for _, key := range keys {
+		ix.processed()
+		f := files[key]
+		indexedFile, ok := indexed[key]
+		if !ok {
+			ix.embed(f.hash, f.path, f.language, f.refs)
+			continue
 		}
-		err = setCodeEmbeddings(redisearchClient, *embeddings, url)
-		if err != nil {
-			log.Fatal(err)
+		for _, ref := range f.refs {
+			if !containsRef(indexedFile.Refs, ref) {
+				ix.addRef(f.hash, f.path, f.language, ref)
+			}
 		}
+		fmt.Printf("Skipping file '%s' since its hash is already processed\n", f.path)
 	}
 
-	// hashList is a list of all hashes in the tree.
-	hashList := make([]string, 0, len(hashes))
-	for k := range hashes {
-		hashList = append(hashList, k)
+	for _, indexedFile := range indexedFiles {
+		if !ix.owns(indexedFile.Path) {
+			continue
+		}
+		f := files[fileKey(indexedFile.Hash, indexedFile.Path)]
+		// Files indexed before refs were recorded belong to the pipeline.
+		if len(indexedFile.Refs) == 0 {
+			if f == nil {
+				ix.deleteFile(indexedFile.Hash, indexedFile.Path)
+			}
+			continue
+		}
+		for _, ref := range indexedFile.Refs {
+			if owned[ref] && (f == nil || !containsRef(f.refs, ref)) {
+				ix.removeRef(indexedFile.Hash, indexedFile.Path, ref)
+			}
+		}
 	}
-	// store the list of hashes as a comma-separated string for the tree (embedding:ns:tree:<tree-hash>)
-	err = redisClient.Set(context.Background(), fmt.Sprintf("%s:embedding:tree:%s", url, tree.Hash.String()), strings.Join(hashList, ","), 0).Err()
-	if err != nil {
-		log.Fatal(err)
+}
+
+// openRepository opens the repository in the storage, initializing it with the origin
+// remote if it doesn't exist. The shards of an execution open the repository at the
+// same time, so one initializing it first isn't an error.
+func openRepository(storer storage.Storer, cloneURL string) (*git.Repository, error) {
+	r, err := git.Open(storer, nil)
+	if err != git.ErrRepositoryNotExists {
+		return r, err
+	}
+	// The refs are fetched by the caller.
+	r, err = git.Init(storer, nil)
+	if err == git.ErrRepositoryAlreadyExists {
+		r, err = git.Open(storer, nil)
 	}
-	// set the embedding:ns:tree equal to the latest tree hash that was processed
-	err = redisClient.Set(context.Background(), fmt.Sprintf("%s:embedding:tree", url), tree.Hash.String(), 0).Err()
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
+	_, err = r.CreateRemote(&config.RemoteConfig{Name: "origin", URLs: []string{cloneURL}})
+	if err != nil && err != git.ErrRemoteExists {
+		return nil, err
+	}
+	return r, nil
 }
 
-func gitAuth(c client.Client, r *v1alpha1.Repository) (githttp.AuthMethod, error) {
+func gitAuth(c client.Client, r *v1alpha1.Repository) (transport.AuthMethod, error) {
 	switch r.Spec.Type {
-	case v1alpha1.RepositoryTypeGithub:
+	case v1alpha1.RepositoryTypeGithub, v1alpha1.RepositoryTypeGitlab:
 		// get the secret
 		secret := &corev1.Secret{}
 		if err := c.Get(context.TODO(), client.ObjectKey{Name: r.Name, Namespace: r.Namespace}, secret); err != nil {
@@ -456,95 +663,97 @@ func gitAuth(c client.Client, r *v1alpha1.Repository) (githttp.AuthMethod, error
 		}
 		token := string(tokenBytes)
 
+		// Gitlab accepts personal, project and group access tokens as the password of the oauth2 user.
+		if r.Spec.Type == v1alpha1.RepositoryTypeGitlab {
+			return &githttp.BasicAuth{Username: "oauth2", Password: token}, nil
+		}
 		return &githttp.BasicAuth{Username: "encoder-run", Password: token}, nil
-	default:
-		return nil, fmt.Errorf("unsupported repository type: %s", r.Spec.Type)
-	}
-}
-
-func postgresStorageStorer(c client.Client, s *v1alpha1.Storage, nsPrefix string) (storage.Storer, *gorm.DB, error) {
-	// get the password from the postgres secret
-	secret := &corev1.Secret{}
-	if err := c.Get(context.TODO(), client.ObjectKey{Name: s.Name, Namespace: s.Namespace}, secret); err != nil {
-		return nil, nil, err
-	}
+	case v1alpha1.RepositoryTypeBitbucket:
+		// get the secret
+		secret := &corev1.Secret{}
+		if err := c.Get(context.TODO(), client.ObjectKey{Name: r.Name, Namespace: r.Namespace}, secret); err != nil {
+			return nil, err
+		}
 
-	hostBytes, ok := secret.Data["host"]
-	if !ok {
-		return nil, nil, errors.New("host not found in secret")
-	}
-	host := string(hostBytes)
+		// App passwords are tied to the username of the account that created them.
+		if username, ok := secret.Data["username"]; ok {
+			password, ok := secret.Data["password"]
+			if !ok {
+				return nil, errors.New("password not found in secret")
+			}
+			return &githttp.BasicAuth{Username: string(username), Password: string(password)}, nil
+		}
 
-	usernameBytes, ok := secret.Data["username"]
-	if !ok {
-		return nil, nil, errors.New("username not found in secret")
-	}
-	username := string(usernameBytes)
+		// Access tokens use the x-token-auth user.
+		tokenBytes, ok := secret.Data["token"]
+		if !ok {
+			return nil, errors.New("token or username and password not found in secret")
+		}
+		return &githttp.BasicAuth{Username: "x-token-auth", Password: string(tokenBytes)}, nil
+	case v1alpha1.RepositoryTypeGit:
+		// Public remotes and local repositories don't need a secret.
+		secret := &corev1.Secret{}
+		if err := c.Get(context.TODO(), client.ObjectKey{Name: r.Name, Namespace: r.Namespace}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
 
-	passwordBytes, ok := secret.Data["password"]
-	if !ok {
-		return nil, nil, errors.New("password not found in secret")
+		if _, ok := secret.Data[corev1.SSHAuthPrivateKey]; ok {
+			return sshAuth(r.Spec.Git.CloneURL, secret)
+		}
+		if username, ok := secret.Data["username"]; ok {
+			return &githttp.BasicAuth{Username: string(username), Password: string(secret.Data["password"])}, nil
+		}
+		if token, ok := secret.Data["token"]; ok {
+			return &githttp.BasicAuth{Username: "encoder-run", Password: string(token)}, nil
+		}
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported repository type: %s", r.Spec.Type)
 	}
-	password := string(passwordBytes)
+}
 
-	databaseBytes, ok := secret.Data["database"]
+// sshAuth creates the ssh auth from the private key in the secret. The host key of the
+// remote is always verified against the known hosts in the secret.
+func sshAuth(cloneURL string, secret *corev1.Secret) (transport.AuthMethod, error) {
+	knownHosts, ok := secret.Data["known_hosts"]
 	if !ok {
-		return nil, nil, errors.New("database not found in secret")
+		return nil, errors.New("known_hosts not found in secret")
 	}
-	db := string(databaseBytes)
 
-	portBytes, ok := secret.Data["port"]
-	if !ok {
-		return nil, nil, errors.New("port not found in secret")
+	ep, err := transport.NewEndpoint(cloneURL)
+	if err != nil {
+		return nil, err
 	}
-	port := string(portBytes)
-
-	sslModeBytes, ok := secret.Data["ssl_mode"]
-	if !ok {
-		return nil, nil, errors.New("ssl_mode not found in secret")
+	user := ep.User
+	if user == "" {
+		user = "git"
 	}
-	sslMode := string(sslModeBytes)
 
-	timezoneBytes, ok := secret.Data["timezone"]
-	if !ok {
-		return nil, nil, errors.New("timezone not found in secret")
+	auth, err := gitssh.NewPublicKeys(user, secret.Data[corev1.SSHAuthPrivateKey], string(secret.Data["passphrase"]))
+	if err != nil {
+		return nil, err
 	}
-	timezone := string(timezoneBytes)
 
-	// Construct the DSN
-
-	dsn := database.ConstructPostgresDSN(host, username, password, db, port, sslMode, timezone)
-
-	dbClient, err := database.GetPostgresClient(dsn)
+	// The known hosts callback only reads from files, which are parsed right away.
+	f, err := os.CreateTemp("", "known_hosts")
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
-
-	// New postgres storage
-	return postgrescache.NewStorage(dbClient, nsPrefix), dbClient, nil
-}
-
-func redisStorageStorer(c client.Client, s *v1alpha1.Storage, nsPrefix string) (storage.Storer, *redis.Options, error) {
-	// get the password from the redis secret
-	secret := &corev1.Secret{}
-	if err := c.Get(context.TODO(), client.ObjectKey{Name: s.Name, Namespace: s.Namespace}, secret); err != nil {
-		return nil, nil, err
+	defer os.Remove(f.Name())
+	defer f.Close()
+	if _, err := f.Write(knownHosts); err != nil {
+		return nil, err
 	}
-
-	passwordBytes, ok := secret.Data["password"]
-	if !ok {
-		return nil, nil, errors.New("password not found in secret")
+	callback, err := gitssh.NewKnownHostsCallback(f.Name())
+	if err != nil {
+		return nil, err
 	}
-	password := string(passwordBytes)
+	auth.HostKeyCallback = callback
 
-	url := fmt.Sprintf("%s.%s.svc.cluster.local:6379", s.Name, s.Namespace)
-	// New redis storage
-	opts := &redis.Options{
-		Addr:     url,
-		Password: password,
-		DB:       0,
-	}
-	return rediscache.NewStorage(opts, nsPrefix), opts, nil
+	return auth, nil
 }
 
 func namespace() (string, error) {
@@ -555,80 +764,6 @@ func namespace() (string, error) {
 	return string(ns), nil
 }
 
-func getRedisearchClient(opts *redis.Options, index string) *redisearch.Client {
-	pool := &redigoredis.Pool{Dial: func() (redigoredis.Conn, error) {
-		return redigoredis.Dial("tcp", opts.Addr, redigoredis.DialPassword(opts.Password))
-	}}
-
-	return redisearch.NewClientFromPool(pool, index)
-}
-
-func createIndex(r *redisearch.Client, ns string) error {
-	// Create a schema for the index
-	sc := redisearch.NewSchema(redisearch.DefaultOptions).
-		AddField(redisearch.NewTextField("file_hash")).
-		AddField(redisearch.NewTextField("file_path")).
-		AddField(redisearch.NewNumericField("chunk_id")).
-		AddField(redisearch.NewNumericField("start_index")).
-		AddField(redisearch.NewNumericField("end_index")).
-		AddField(redisearch.NewVectorFieldOptions("embedding", redisearch.VectorFieldOptions{
-			Algorithm: redisearch.Flat,
-			Attributes: map[string]interface{}{
-				"TYPE":            "FLOAT32",
-				"DIM":             768, // Adjust this to the dimension of your embeddings
-				"DISTANCE_METRIC": "COSINE",
-			},
-		}))
-
-	indexDef := redisearch.NewIndexDefinition().AddPrefix(fmt.Sprintf("%s:embedding:code:", ns))
-
-	info, _ := r.Info()
-	if info == nil {
-		// Create the index with the schema
-		fmt.Printf("Creating index\n")
-		if err := r.CreateIndexWithIndexDefinition(sc, indexDef); err != nil {
-			return err
-		}
-		return nil
-	}
-	fmt.Printf("Index already exists\n")
-	return nil
-}
-
-func setCodeEmbeddings(r *redisearch.Client, embeddings embedder.CodeEmbeddingsResponse, namespace string) error {
-	// Use a redis pipeline to set all embeddings in one go
-	docs := make([]redisearch.Document, 0)
-	for filePath, embs := range embeddings.Results {
-		for _, emb := range embs.Embeddings {
-			// Create a unique key for each embedding
-			key := fmt.Sprintf("%s:embedding:%s:%s:%d", namespace, "code", emb.FileHash, emb.ChunkID)
-			doc := redisearch.NewDocument(key, 1.0)
-			doc.Set("fileHash", emb.FileHash)
-			doc.Set("filePath", filePath)
-			doc.Set("chunkID", emb.ChunkID)
-			doc.Set("startIndex", emb.StartIndex)
-			doc.Set("endIndex", emb.EndIndex)
-			// Convert embedding float slice to bytes
-			buf := new(bytes.Buffer)
-			for _, val := range emb.Embedding {
-				if err := binary.Write(buf, binary.LittleEndian, val); err != nil {
-					return err // Handle error appropriately
-				}
-			}
-			doc.Set("embedding", buf.Bytes())
-			docs = append(docs, doc)
-		}
-	}
-
-	if err := r.IndexOptions(redisearch.IndexingOptions{
-		Replace: true,
-	}, docs...); err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func newScheme() *runtime.Scheme {
 	scheme := runtime.NewScheme()
 	_ = corev1.AddToScheme(scheme)
@@ -678,9 +813,27 @@ func CheckIfError(err error) {
 	}
 
 	fmt.Printf("\x1b[31;1m%s\x1b[0m\n", fmt.Sprintf("error: %s", err))
+	terminationLog{}.Write([]byte(err.Error()))
 	os.Exit(1)
 }
 
+// terminationLogPath is read by kubernetes as the termination message of the container.
+const terminationLogPath = "/dev/termination-log"
+
+// terminationLog overwrites the termination message of the container with every write,
+// so it holds the last message logged before the embedder exited.
+type terminationLog struct{}
+
+func (terminationLog) Write(p []byte) (int, error) {
+	// The termination log only exists in a container.
+	f, err := os.OpenFile(terminationLogPath, os.O_WRONLY|os.O_TRUNC, 0)
+	if err != nil {
+		return len(p), nil
+	}
+	defer f.Close()
+	return f.Write(p)
+}
+
 // Info should be used to describe the example commands that are about to run.
 func Info(format string, args ...interface{}) {
 	fmt.Printf("\x1b[34;1m%s\x1b[0m\n", fmt.Sprintf(format, args...))
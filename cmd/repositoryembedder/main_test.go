@@ -0,0 +1,696 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/embedder"
+	"github.com/encoder-run/operator/pkg/vectorstore"
+)
+
+func TestGitAuth(t *testing.T) {
+	tests := []struct {
+		name       string
+		repoType   v1alpha1.RepositoryType
+		secretData map[string][]byte
+		want       interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "github token",
+			repoType:   v1alpha1.RepositoryTypeGithub,
+			secretData: map[string][]byte{"token": []byte("ghp_token")},
+			want:       &githttp.BasicAuth{Username: "encoder-run", Password: "ghp_token"},
+		},
+		{
+			name:       "gitlab token",
+			repoType:   v1alpha1.RepositoryTypeGitlab,
+			secretData: map[string][]byte{"token": []byte("glpat_token")},
+			want:       &githttp.BasicAuth{Username: "oauth2", Password: "glpat_token"},
+		},
+		{
+			name:       "bitbucket app password",
+			repoType:   v1alpha1.RepositoryTypeBitbucket,
+			secretData: map[string][]byte{"username": []byte("user"), "password": []byte("app_password")},
+			want:       &githttp.BasicAuth{Username: "user", Password: "app_password"},
+		},
+		{
+			name:       "bitbucket access token",
+			repoType:   v1alpha1.RepositoryTypeBitbucket,
+			secretData: map[string][]byte{"token": []byte("access_token")},
+			want:       &githttp.BasicAuth{Username: "x-token-auth", Password: "access_token"},
+		},
+		{
+			name:       "bitbucket username without password",
+			repoType:   v1alpha1.RepositoryTypeBitbucket,
+			secretData: map[string][]byte{"username": []byte("user"), "token": []byte("access_token")},
+			wantErr:    true,
+		},
+		{
+			name:       "bitbucket without credentials",
+			repoType:   v1alpha1.RepositoryTypeBitbucket,
+			secretData: map[string][]byte{"password": []byte("app_password")},
+			wantErr:    true,
+		},
+		{
+			name:     "git without a secret",
+			repoType: v1alpha1.RepositoryTypeGit,
+			want:     nil,
+		},
+		{
+			name:       "git token",
+			repoType:   v1alpha1.RepositoryTypeGit,
+			secretData: map[string][]byte{"token": []byte("token")},
+			want:       &githttp.BasicAuth{Username: "encoder-run", Password: "token"},
+		},
+		{
+			name:       "git username and password",
+			repoType:   v1alpha1.RepositoryTypeGit,
+			secretData: map[string][]byte{"username": []byte("user"), "password": []byte("password")},
+			want:       &githttp.BasicAuth{Username: "user", Password: "password"},
+		},
+		{
+			name:       "git secret without credentials",
+			repoType:   v1alpha1.RepositoryTypeGit,
+			secretData: map[string][]byte{"other": []byte("value")},
+			want:       nil,
+		},
+		{
+			name:       "missing token",
+			repoType:   v1alpha1.RepositoryTypeGitlab,
+			secretData: map[string][]byte{"password": []byte("secret")},
+			wantErr:    true,
+		},
+		{
+			name:     "missing secret",
+			repoType: v1alpha1.RepositoryTypeGithub,
+			wantErr:  true,
+		},
+		{
+			name:       "unsupported type",
+			repoType:   "SVN",
+			secretData: map[string][]byte{"token": []byte("token")},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(newScheme())
+			if tt.secretData != nil {
+				builder = builder.WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "repository", Namespace: "default"},
+					Data:       tt.secretData,
+				})
+			}
+			repo := &v1alpha1.Repository{
+				ObjectMeta: metav1.ObjectMeta{Name: "repository", Namespace: "default"},
+				Spec: v1alpha1.RepositorySpec{
+					Type: tt.repoType,
+					Git:  &v1alpha1.GitRepositorySpec{CloneURL: "git@example.com:team/repo.git"},
+				},
+			}
+
+			got, err := gitAuth(builder.Build(), repo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("gitAuth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("gitAuth() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSSHAuth(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+	hostKey, err := ssh.NewPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	knownHosts := append([]byte("example.com "), ssh.MarshalAuthorizedKey(hostKey)...)
+
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherHostKey, err := ssh.NewPublicKey(otherPub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		cloneURL string
+		data     map[string][]byte
+		wantUser string
+		wantErr  bool
+	}{
+		{
+			name:     "scp-like clone URL",
+			cloneURL: "git@example.com:team/repo.git",
+			data:     map[string][]byte{corev1.SSHAuthPrivateKey: privateKey, "known_hosts": knownHosts},
+			wantUser: "git",
+		},
+		{
+			name:     "user of the clone URL",
+			cloneURL: "ssh://deploy@example.com/team/repo.git",
+			data:     map[string][]byte{corev1.SSHAuthPrivateKey: privateKey, "known_hosts": knownHosts},
+			wantUser: "deploy",
+		},
+		{
+			name:     "clone URL without a user",
+			cloneURL: "ssh://example.com/team/repo.git",
+			data:     map[string][]byte{corev1.SSHAuthPrivateKey: privateKey, "known_hosts": knownHosts},
+			wantUser: "git",
+		},
+		{
+			name:     "missing known hosts",
+			cloneURL: "git@example.com:team/repo.git",
+			data:     map[string][]byte{corev1.SSHAuthPrivateKey: privateKey},
+			wantErr:  true,
+		},
+		{
+			name:     "invalid private key",
+			cloneURL: "git@example.com:team/repo.git",
+			data:     map[string][]byte{corev1.SSHAuthPrivateKey: []byte("invalid"), "known_hosts": knownHosts},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sshAuth(tt.cloneURL, &corev1.Secret{Data: tt.data})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sshAuth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			auth, ok := got.(*gitssh.PublicKeys)
+			if !ok {
+				t.Fatalf("sshAuth() = %T, want *ssh.PublicKeys", got)
+			}
+			if auth.User != tt.wantUser {
+				t.Errorf("sshAuth() user = %q, want %q", auth.User, tt.wantUser)
+			}
+			addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 22}
+			if err := auth.HostKeyCallback("example.com:22", addr, hostKey); err != nil {
+				t.Errorf("HostKeyCallback() of the known host key error = %v", err)
+			}
+			if err := auth.HostKeyCallback("example.com:22", addr, otherHostKey); err == nil {
+				t.Error("HostKeyCallback() of an unknown host key succeeded")
+			}
+		})
+	}
+}
+
+// testFile is a file of a tree built by buildTree.
+type testFile struct {
+	content string
+	mode    filemode.FileMode
+}
+
+// buildTree stores the files as a flat tree in the storage.
+func buildTree(t *testing.T, st *memory.Storage, files map[string]testFile) *object.Tree {
+	t.Helper()
+	tree := &object.Tree{}
+	for name, f := range files {
+		blob := st.NewEncodedObject()
+		blob.SetType(plumbing.BlobObject)
+		w, err := blob.Writer()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+		_ = w.Close()
+		hash, err := st.SetEncodedObject(blob)
+		if err != nil {
+			t.Fatal(err)
+		}
+		mode := f.mode
+		if mode == 0 {
+			mode = filemode.Regular
+		}
+		tree.Entries = append(tree.Entries, object.TreeEntry{Name: name, Mode: mode, Hash: hash})
+	}
+	sort.Slice(tree.Entries, func(i, j int) bool { return tree.Entries[i].Name < tree.Entries[j].Name })
+
+	obj := st.NewEncodedObject()
+	if err := tree.Encode(obj); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := st.SetEncodedObject(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := object.GetTree(st, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+// embeddingServer returns an OpenAI compatible server returning embeddings of dimension 1.
+func embeddingServer(t *testing.T) *embedder.EmbeddingClient {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Input []string `json:"input"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data := make([]map[string]interface{}, 0, len(req.Input))
+		for i := range req.Input {
+			data = append(data, map[string]interface{}{"index": i, "embedding": []float32{1}})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+	}))
+	t.Cleanup(server.Close)
+	return embedder.NewOpenAIClient(server.URL, "model", "")
+}
+
+// fileHash returns the hash of the file of the tree.
+func fileHash(t *testing.T, tree *object.Tree, name string) string {
+	t.Helper()
+	f, err := tree.File(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f.Hash.String()
+}
+
+func TestProcessChanges(t *testing.T) {
+	st := memory.NewStorage()
+	r, err := git.Init(st, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := buildTree(t, st, map[string]testFile{
+		"main.go":     {content: "package main\n"},
+		"removed.go":  {content: "package removed\n"},
+		"modified.py": {content: "import os\n"},
+		"logo.png":    {content: "png\n"},
+		"mode.go":     {content: "package mode\n"},
+		"LICENSE":     {content: "license\n"},
+	})
+	to := buildTree(t, st, map[string]testFile{
+		"main.go":     {content: "package main\n"},
+		"modified.py": {content: "import sys\n"},
+		"added.go":    {content: "package added\n"},
+		"mode.go":     {content: "package mode\n", mode: filemode.Executable},
+		"LICENSE":     {content: "changed license\n"},
+	})
+
+	const main, dev = "refs/heads/main", "refs/heads/dev"
+	store := newFakeStore(
+		vectorstore.IndexedFile{Hash: fileHash(t, from, "main.go"), Path: "main.go", Refs: []string{main, dev}},
+		vectorstore.IndexedFile{Hash: fileHash(t, from, "removed.go"), Path: "removed.go", Refs: []string{main, dev}},
+		vectorstore.IndexedFile{Hash: fileHash(t, from, "modified.py"), Path: "modified.py", Refs: []string{main}},
+		vectorstore.IndexedFile{Hash: fileHash(t, from, "mode.go"), Path: "mode.go", Refs: []string{main}},
+	)
+	ix := newIndexer(r, embeddingServer(t), nil, store, 1)
+	processChanges(ix, main, from, to)
+	ix.finish()
+
+	// Unchanged files, mode changes and unsupported files are skipped.
+	wantEmbedded := []string{
+		fileKey(fileHash(t, to, "added.go"), "added.go"),
+		fileKey(fileHash(t, to, "modified.py"), "modified.py"),
+	}
+	sort.Strings(store.embedded)
+	if !reflect.DeepEqual(store.embedded, wantEmbedded) {
+		t.Errorf("processChanges() embedded %v, want %v", store.embedded, wantEmbedded)
+	}
+	// The ref is removed from the previous content, other refs keep their files.
+	wantRefs := map[string][]string{
+		"main.go":     {dev, main},
+		"removed.go":  {dev},
+		"modified.py": {main},
+		"added.go":    {main},
+		"mode.go":     {main},
+	}
+	if got := store.refs(); !reflect.DeepEqual(got, wantRefs) {
+		t.Errorf("processChanges() refs = %v, want %v", got, wantRefs)
+	}
+	if len(store.files) != len(wantRefs) {
+		t.Errorf("processChanges() left %d files, want %d", len(store.files), len(wantRefs))
+	}
+	wantStats := v1alpha1.ExecutionStats{FilesScanned: 2, FilesEmbedded: 2, FilesDeleted: 2, ChunksWritten: 2}
+	if got := ix.executionStats(); *got != wantStats {
+		t.Errorf("processChanges() stats = %+v, want %+v", *got, wantStats)
+	}
+}
+
+func TestProcessEmbeddings(t *testing.T) {
+	st := memory.NewStorage()
+	r, err := git.Init(st, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainTree := buildTree(t, st, map[string]testFile{
+		"shared.go": {content: "package shared\n"},
+		"main.go":   {content: "package main\n"},
+	})
+	devTree := buildTree(t, st, map[string]testFile{
+		"shared.go": {content: "package shared\n"},
+		"dev.go":    {content: "package dev\n"},
+	})
+	oldTree := buildTree(t, st, map[string]testFile{
+		"old.go":    {content: "package old\n"},
+		"legacy.go": {content: "package legacy\n"},
+		"other.go":  {content: "package other\n"},
+	})
+
+	const main, dev, other = "refs/heads/main", "refs/heads/dev", "refs/heads/other"
+	store := newFakeStore(
+		vectorstore.IndexedFile{Hash: fileHash(t, mainTree, "main.go"), Path: "main.go", Refs: []string{main}},
+		// Removed from the tree of an owned ref.
+		vectorstore.IndexedFile{Hash: fileHash(t, oldTree, "old.go"), Path: "old.go", Refs: []string{main, "refs/tags/v1"}},
+		// Indexed before refs were recorded.
+		vectorstore.IndexedFile{Hash: fileHash(t, oldTree, "legacy.go"), Path: "legacy.go"},
+		// Indexed by another pipeline.
+		vectorstore.IndexedFile{Hash: fileHash(t, oldTree, "other.go"), Path: "other.go", Refs: []string{other}},
+	)
+	refs := []indexedRef{{name: main, tree: mainTree}, {name: dev, tree: devTree}}
+	pipeline := &v1alpha1.Pipeline{Status: v1alpha1.PipelineStatus{IndexedCommits: []v1alpha1.IndexedCommit{{Ref: "refs/tags/v1"}}}}
+	ix := newIndexer(r, embeddingServer(t), nil, store, 1)
+	processEmbeddings(ix, refs, ownedRefs(pipeline, refs))
+	ix.finish()
+
+	// Files of both refs are embedded once.
+	wantEmbedded := []string{
+		fileKey(fileHash(t, devTree, "dev.go"), "dev.go"),
+		fileKey(fileHash(t, mainTree, "shared.go"), "shared.go"),
+	}
+	sort.Strings(store.embedded)
+	if !reflect.DeepEqual(store.embedded, wantEmbedded) {
+		t.Errorf("processEmbeddings() embedded %v, want %v", store.embedded, wantEmbedded)
+	}
+	wantRefs := map[string][]string{
+		"shared.go": {dev, main},
+		"main.go":   {main},
+		"dev.go":    {dev},
+		"other.go":  {other},
+	}
+	if got := store.refs(); !reflect.DeepEqual(got, wantRefs) {
+		t.Errorf("processEmbeddings() refs = %v, want %v", got, wantRefs)
+	}
+	// The file indexed for main is skipped.
+	wantStats := v1alpha1.ExecutionStats{FilesScanned: 3, FilesSkipped: 1, FilesEmbedded: 2, FilesDeleted: 3, ChunksWritten: 2}
+	if got := ix.executionStats(); *got != wantStats {
+		t.Errorf("processEmbeddings() stats = %+v, want %+v", *got, wantStats)
+	}
+}
+
+func TestRecordExecution(t *testing.T) {
+	st := memory.NewStorage()
+	if _, err := git.Init(st, nil); err != nil {
+		t.Fatal(err)
+	}
+	tree := buildTree(t, st, map[string]testFile{"main.go": {content: "package main\n"}})
+	commit := &object.Commit{Hash: plumbing.NewHash("0123456789abcdef0123456789abcdef01234567")}
+
+	pe := &v1alpha1.PipelineExecution{ObjectMeta: metav1.ObjectMeta{Name: "execution", Namespace: "default"}}
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(pe).WithStatusSubresource(pe).Build()
+	refs := []indexedRef{{name: "refs/heads/main", commit: commit, tree: tree}}
+	stats := &v1alpha1.ExecutionStats{FilesScanned: 3, FilesSkipped: 1, FilesEmbedded: 2, ChunksWritten: 5}
+	if err := recordExecution(c, "default", "execution", refs, stats); err != nil {
+		t.Fatalf("recordExecution() error = %v", err)
+	}
+
+	got := &v1alpha1.PipelineExecution{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Name: "execution", Namespace: "default"}, got); err != nil {
+		t.Fatal(err)
+	}
+	wantCommits := []v1alpha1.IndexedCommit{{Ref: "refs/heads/main", Commit: commit.Hash.String(), Tree: tree.Hash.String()}}
+	if !reflect.DeepEqual(got.Status.Commits, wantCommits) {
+		t.Errorf("recordExecution() commits = %v, want %v", got.Status.Commits, wantCommits)
+	}
+	if !reflect.DeepEqual(got.Status.Stats, stats) {
+		t.Errorf("recordExecution() stats = %+v, want %+v", got.Status.Stats, stats)
+	}
+	if err := recordExecution(c, "default", "missing", refs, stats); err == nil {
+		t.Errorf("recordExecution() of a missing execution error = nil, want an error")
+	}
+}
+
+func TestRecordShard(t *testing.T) {
+	st := memory.NewStorage()
+	if _, err := git.Init(st, nil); err != nil {
+		t.Fatal(err)
+	}
+	tree := buildTree(t, st, map[string]testFile{"main.go": {content: "package main\n"}})
+	commit := &object.Commit{Hash: plumbing.NewHash("0123456789abcdef0123456789abcdef01234567")}
+
+	pe := &v1alpha1.PipelineExecution{ObjectMeta: metav1.ObjectMeta{Name: "execution", Namespace: "default"}}
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(pe).WithStatusSubresource(pe).Build()
+	if err := recordShard(c, "default", "execution", "0", &v1alpha1.ExecutionStats{FilesScanned: 2, FilesEmbedded: 2, ChunksWritten: 4}); err != nil {
+		t.Fatalf("recordShard() error = %v", err)
+	}
+	if err := recordShard(c, "default", "execution", "1", &v1alpha1.ExecutionStats{FilesScanned: 3, FilesSkipped: 1, FilesEmbedded: 2, ChunksWritten: 3}); err != nil {
+		t.Fatalf("recordShard() error = %v", err)
+	}
+
+	// The finalize run adds the stats of the shards to its own.
+	refs := []indexedRef{{name: "refs/heads/main", commit: commit, tree: tree}}
+	if err := recordExecution(c, "default", "execution", refs, &v1alpha1.ExecutionStats{FilesDeleted: 1}); err != nil {
+		t.Fatalf("recordExecution() error = %v", err)
+	}
+	got := &v1alpha1.PipelineExecution{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Name: "execution", Namespace: "default"}, got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Status.ShardStats) != 2 {
+		t.Errorf("recordShard() shard stats = %v, want the stats of both shards", got.Status.ShardStats)
+	}
+	want := &v1alpha1.ExecutionStats{FilesScanned: 5, FilesSkipped: 1, FilesEmbedded: 4, FilesDeleted: 1, ChunksWritten: 7}
+	if !reflect.DeepEqual(got.Status.Stats, want) {
+		t.Errorf("recordExecution() stats = %+v, want %+v", got.Status.Stats, want)
+	}
+}
+
+func TestProcessEmbeddingsSharded(t *testing.T) {
+	st := memory.NewStorage()
+	r, err := git.Init(st, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]testFile)
+	for i := 0; i < 20; i++ {
+		files[fmt.Sprintf("pkg/file%d.go", i)] = testFile{content: fmt.Sprintf("package pkg\n\nconst n = %d\n", i)}
+	}
+	tree := buildTree(t, st, files)
+	refs := []indexedRef{{name: "refs/heads/main", tree: tree}}
+
+	// Every file is embedded by exactly one of the shards.
+	store := newFakeStore()
+	var scanned int64
+	for shard := 0; shard < 3; shard++ {
+		ix := newIndexer(r, embeddingServer(t), nil, store, 1)
+		ix.shard, ix.shards = shard, 3
+		processEmbeddings(ix, refs, ownedRefs(nil, refs))
+		ix.finish()
+		scanned += ix.executionStats().FilesScanned
+	}
+	if len(store.embedded) != len(files) || len(store.files) != len(files) {
+		t.Errorf("processEmbeddings() of the shards embedded %d files, want %d", len(store.embedded), len(files))
+	}
+	if scanned != int64(len(files)) {
+		t.Errorf("processEmbeddings() of the shards scanned %d files, want %d", scanned, len(files))
+	}
+}
+
+func TestOpenRepository(t *testing.T) {
+	const cloneURL = "https://github.com/encoder-run/operator.git"
+	st := memory.NewStorage()
+
+	// The first shard initializes the repository, the others open it.
+	for i := 0; i < 2; i++ {
+		r, err := openRepository(st, cloneURL)
+		if err != nil {
+			t.Fatalf("openRepository() error = %v", err)
+		}
+		remote, err := r.Remote("origin")
+		if err != nil {
+			t.Fatalf("openRepository() remote error = %v", err)
+		}
+		if urls := remote.Config().URLs; !reflect.DeepEqual(urls, []string{cloneURL}) {
+			t.Errorf("openRepository() origin = %v, want %s", urls, cloneURL)
+		}
+	}
+}
+
+func TestLastIndexedTrees(t *testing.T) {
+	st := memory.NewStorage()
+	r, err := git.Init(st, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := buildTree(t, st, map[string]testFile{"main.go": {content: "package main\n"}})
+	commit := &object.Commit{
+		Author:    object.Signature{Name: "encoder-run"},
+		Committer: object.Signature{Name: "encoder-run"},
+		Message:   "initial commit",
+		TreeHash:  tree.Hash,
+	}
+	obj := st.NewEncodedObject()
+	if err := commit.Encode(obj); err != nil {
+		t.Fatal(err)
+	}
+	commitHash, err := st.SetEncodedObject(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	refs := []indexedRef{{name: "refs/heads/main"}}
+	tests := []struct {
+		name    string
+		indexed []v1alpha1.IndexedCommit
+		want    bool
+	}{
+		{name: "indexed commit", indexed: []v1alpha1.IndexedCommit{{Ref: "refs/heads/main", Commit: commitHash.String()}}, want: true},
+		{name: "never indexed", want: false},
+		{name: "other ref", indexed: []v1alpha1.IndexedCommit{{Ref: "refs/heads/dev", Commit: commitHash.String()}}, want: false},
+		{
+			name:    "refs changed",
+			indexed: []v1alpha1.IndexedCommit{{Ref: "refs/heads/main", Commit: commitHash.String()}, {Ref: "refs/heads/dev", Commit: commitHash.String()}},
+			want:    false,
+		},
+		{
+			name:    "commit not in the storage",
+			indexed: []v1alpha1.IndexedCommit{{Ref: "refs/heads/main", Commit: "0123456789abcdef0123456789abcdef01234567"}},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := &v1alpha1.Pipeline{Status: v1alpha1.PipelineStatus{IndexedCommits: tt.indexed}}
+			got := lastIndexedTrees(r, pipeline, refs)
+			if (got != nil) != tt.want {
+				t.Fatalf("lastIndexedTrees() = %v, want trees %v", got, tt.want)
+			}
+			if tt.want && got["refs/heads/main"].Hash != tree.Hash {
+				t.Errorf("lastIndexedTrees() = %v, want the tree of the indexed commit", got)
+			}
+		})
+	}
+}
+
+func TestUpdateSnapshots(t *testing.T) {
+	st := memory.NewStorage()
+	r, err := git.Init(st, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := buildTree(t, st, map[string]testFile{
+		"main.go":  {content: "package main\n"},
+		"added.go": {content: "package added\n"},
+	})
+	mainHash := fileHash(t, tree, "main.go")
+	oldHash := plumbing.NewHash("0123456789abcdef0123456789abcdef01234567").String()
+
+	const c1, c2, c3 = "1111111111111111111111111111111111111111", "2222222222222222222222222222222222222222", "3333333333333333333333333333333333333333"
+	snapshot := func(commit string) v1alpha1.IndexedCommit {
+		return v1alpha1.IndexedCommit{Ref: "refs/heads/main", Commit: commit}
+	}
+	refs := []indexedRef{
+		{name: "refs/heads/main", commit: &object.Commit{Hash: plumbing.NewHash(c3)}, tree: tree},
+		// The same commit is snapshotted once.
+		{name: "refs/tags/v1", commit: &object.Commit{Hash: plumbing.NewHash(c3)}, tree: tree},
+	}
+
+	tests := []struct {
+		name          string
+		keep          int
+		wantSnapshots []string
+		wantRefs      map[string][]string
+	}{
+		{
+			name:          "oldest snapshot is pruned",
+			keep:          2,
+			wantSnapshots: []string{c3, c2},
+			wantRefs: map[string][]string{
+				"main.go":  {"refs/heads/main", vectorstore.SnapshotRef(c2), vectorstore.SnapshotRef(c3)},
+				"added.go": {"refs/heads/main", vectorstore.SnapshotRef(c3)},
+			},
+		},
+		{
+			name:          "snapshots disabled",
+			keep:          0,
+			wantSnapshots: []string{},
+			wantRefs: map[string][]string{
+				"main.go":  {"refs/heads/main"},
+				"added.go": {"refs/heads/main"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newFakeStore(
+				vectorstore.IndexedFile{Hash: mainHash, Path: "main.go", Refs: []string{"refs/heads/main", vectorstore.SnapshotRef(c1), vectorstore.SnapshotRef(c2)}},
+				vectorstore.IndexedFile{Hash: fileHash(t, tree, "added.go"), Path: "added.go", Refs: []string{"refs/heads/main"}},
+				// Only in the oldest snapshot.
+				vectorstore.IndexedFile{Hash: oldHash, Path: "old.go", Refs: []string{vectorstore.SnapshotRef(c1)}},
+			)
+			pipeline := &v1alpha1.Pipeline{
+				Spec:   v1alpha1.PipelineSpec{RepositoryEmbeddings: &v1alpha1.RepositoryEmbeddingsSpec{Snapshots: tt.keep}},
+				Status: v1alpha1.PipelineStatus{Snapshots: []v1alpha1.IndexedCommit{snapshot(c2), snapshot(c1)}},
+			}
+			ix := newIndexer(r, embeddingServer(t), nil, store, 1)
+			got := updateSnapshots(ix, pipeline, refs)
+
+			commits := make([]string, 0, len(got))
+			for _, s := range got {
+				commits = append(commits, s.Commit)
+			}
+			if !reflect.DeepEqual(commits, tt.wantSnapshots) {
+				t.Errorf("updateSnapshots() = %v, want %v", commits, tt.wantSnapshots)
+			}
+			if len(store.embedded) != 0 {
+				t.Errorf("updateSnapshots() embedded %v, want none", store.embedded)
+			}
+			if got := store.refs(); !reflect.DeepEqual(got, tt.wantRefs) {
+				t.Errorf("updateSnapshots() refs = %v, want %v", got, tt.wantRefs)
+			}
+		})
+	}
+}
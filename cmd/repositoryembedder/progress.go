@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// progressInterval is the minimum time between two progress reports.
+const progressInterval = 10 * time.Second
+
+// progressPatch is the progress in the merge patch of a report. The estimated
+// completion time is sent even if not set, so that a null clears the estimate of the
+// previous report.
+type progressPatch struct {
+	v1alpha1.ExecutionProgress
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime"`
+}
+
+// progressReporter patches the progress of the indexer onto the pipeline execution.
+type progressReporter struct {
+	c         client.Client
+	execution *v1alpha1.PipelineExecution
+	// shardKey is the key of the shard progress of a sharded execution
+	shardKey string
+	start    time.Time
+	last     time.Time
+}
+
+func newProgressReporter(c client.Client, ns, executionId, shardKey string) *progressReporter {
+	return &progressReporter{
+		c:         c,
+		execution: &v1alpha1.PipelineExecution{ObjectMeta: metav1.ObjectMeta{Name: executionId, Namespace: ns}},
+		shardKey:  shardKey,
+		start:     time.Now(),
+	}
+}
+
+// report patches the progress if the last report is older than the progress interval,
+// or regardless of its age if force is set.
+func (p *progressReporter) report(progress v1alpha1.ExecutionProgress, force bool) {
+	if p == nil {
+		return
+	}
+	now := time.Now()
+	if !force && now.Sub(p.last) < progressInterval {
+		return
+	}
+	p.last = now
+
+	progress.UpdateTime = metav1.NewTime(now)
+	if progress.FilesProcessed > 0 && progress.FilesTotal > progress.FilesProcessed {
+		perFile := now.Sub(p.start) / time.Duration(progress.FilesProcessed)
+		eta := metav1.NewTime(now.Add(perFile * time.Duration(progress.FilesTotal-progress.FilesProcessed)))
+		progress.EstimatedCompletionTime = &eta
+	}
+	fmt.Printf("Processed %d of %d files in %d batches\n", progress.FilesProcessed, progress.FilesTotal, progress.Batch)
+
+	// Only the progress is patched so the status set by the controller is kept. The
+	// shards of an execution patch their own keys of the shard progress.
+	value := progressPatch{ExecutionProgress: progress, EstimatedCompletionTime: progress.EstimatedCompletionTime}
+	status := map[string]interface{}{"progress": value}
+	if p.shardKey != "" {
+		status = map[string]interface{}{
+			"shardProgress": map[string]interface{}{p.shardKey: value},
+		}
+	}
+	patch, err := json.Marshal(map[string]interface{}{"status": status})
+	if err != nil {
+		fmt.Printf("Failed to report the progress: %v\n", err)
+		return
+	}
+	// The progress is informational, indexing goes on if it can't be reported.
+	if err := p.c.Status().Patch(context.TODO(), p.execution, client.RawPatch(types.MergePatchType, patch)); err != nil {
+		fmt.Printf("Failed to report the progress: %v\n", err)
+	}
+}
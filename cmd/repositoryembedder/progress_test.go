@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+func TestProgressReporterReport(t *testing.T) {
+	pe := &v1alpha1.PipelineExecution{ObjectMeta: metav1.ObjectMeta{Name: "execution", Namespace: "default"}}
+	state := v1alpha1.PipelineExecutionStateActive
+	pe.Status.State = &state
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(pe).WithStatusSubresource(pe).Build()
+	progress := func() *v1alpha1.ExecutionProgress {
+		t.Helper()
+		got := &v1alpha1.PipelineExecution{}
+		if err := c.Get(context.TODO(), client.ObjectKeyFromObject(pe), got); err != nil {
+			t.Fatal(err)
+		}
+		// The status set by the controller is kept.
+		if got.Status.State == nil || *got.Status.State != state {
+			t.Errorf("report() state = %v, want %s", got.Status.State, state)
+		}
+		return got.Status.Progress
+	}
+
+	p := newProgressReporter(c, "default", "execution", "")
+	p.start = time.Now().Add(-time.Minute)
+	p.report(v1alpha1.ExecutionProgress{FilesProcessed: 1, FilesTotal: 4, Batch: 1}, false)
+	got := progress()
+	if got == nil || got.FilesProcessed != 1 || got.FilesTotal != 4 || got.Batch != 1 {
+		t.Fatalf("report() progress = %+v, want 1 of 4 files in 1 batch", got)
+	}
+	// Three files left at a minute per file.
+	if eta := got.EstimatedCompletionTime; eta == nil || eta.Sub(got.UpdateTime.Time) < 2*time.Minute {
+		t.Errorf("report() estimated completion = %v, want about 3 minutes after %v", eta, got.UpdateTime)
+	}
+
+	// Reports within the interval are dropped unless forced.
+	p.report(v1alpha1.ExecutionProgress{FilesProcessed: 2, FilesTotal: 4}, false)
+	if got := progress(); got.FilesProcessed != 1 {
+		t.Errorf("report() within the interval processed = %d, want 1", got.FilesProcessed)
+	}
+	p.report(v1alpha1.ExecutionProgress{FilesProcessed: 4, FilesTotal: 4, Batch: 2}, true)
+	got = progress()
+	if got.FilesProcessed != 4 || got.Batch != 2 {
+		t.Errorf("forced report() progress = %+v, want 4 files in 2 batches", got)
+	}
+	if got.EstimatedCompletionTime != nil {
+		t.Errorf("report() of all files estimated completion = %v, want none", got.EstimatedCompletionTime)
+	}
+
+	// Indexers without an execution have no reporter.
+	var none *progressReporter
+	none.report(v1alpha1.ExecutionProgress{FilesProcessed: 1}, true)
+}
+
+func TestProgressReporterReportShard(t *testing.T) {
+	pe := &v1alpha1.PipelineExecution{ObjectMeta: metav1.ObjectMeta{Name: "execution", Namespace: "default"}}
+	c := fake.NewClientBuilder().WithScheme(newScheme()).WithObjects(pe).WithStatusSubresource(pe).Build()
+
+	// The shards patch their own keys of the shard progress.
+	newProgressReporter(c, "default", "execution", "0").report(v1alpha1.ExecutionProgress{FilesProcessed: 1, FilesTotal: 2}, true)
+	newProgressReporter(c, "default", "execution", "1").report(v1alpha1.ExecutionProgress{FilesProcessed: 3, FilesTotal: 3}, true)
+
+	got := &v1alpha1.PipelineExecution{}
+	if err := c.Get(context.TODO(), client.ObjectKeyFromObject(pe), got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Progress != nil {
+		t.Errorf("report() of a shard progress = %+v, want none", got.Status.Progress)
+	}
+	if len(got.Status.ShardProgress) != 2 || got.Status.ShardProgress["0"].FilesProcessed != 1 || got.Status.ShardProgress["1"].FilesProcessed != 3 {
+		t.Errorf("report() shard progress = %+v, want the progress of both shards", got.Status.ShardProgress)
+	}
+}
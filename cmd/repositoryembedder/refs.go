@@ -0,0 +1,77 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// indexedRef is a branch or tag of the repository with the commit that is indexed.
+type indexedRef struct {
+	name   plumbing.ReferenceName
+	commit *object.Commit
+	tree   *object.Tree
+}
+
+// resolveRefs returns the branches and tags of the remote matching the patterns, e.g.
+// main, v1.0, release/* or refs/tags/v*. Patterns without the refs/ prefix match the
+// short names of both branches and tags.
+func resolveRefs(remoteRefs []*plumbing.Reference, patterns []string) ([]plumbing.ReferenceName, error) {
+	names := make([]plumbing.ReferenceName, 0)
+	seen := make(map[plumbing.ReferenceName]bool)
+	for _, ref := range remoteRefs {
+		name := ref.Name()
+		if (!name.IsBranch() && !name.IsTag()) || seen[name] {
+			continue
+		}
+		for _, pattern := range patterns {
+			ok, err := matchRef(pattern, name)
+			if err != nil {
+				return nil, fmt.Errorf("invalid ref pattern %q: %w", pattern, err)
+			}
+			if ok {
+				seen[name] = true
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names, nil
+}
+
+func matchRef(pattern string, name plumbing.ReferenceName) (bool, error) {
+	return v1alpha1.MatchRef(pattern, name.String())
+}
+
+// localRefName returns the name of the ref in the storage. Branches are stored as
+// remote tracking branches and tags as they are.
+func localRefName(name plumbing.ReferenceName) plumbing.ReferenceName {
+	if name.IsBranch() {
+		return plumbing.NewRemoteReferenceName("origin", name.Short())
+	}
+	return name
+}
+
+// fetchRefSpec returns the refspec fetching the ref into the storage.
+func fetchRefSpec(name plumbing.ReferenceName) config.RefSpec {
+	return config.RefSpec(fmt.Sprintf("+%s:%s", name, localRefName(name)))
+}
+
+// refCommit returns the fetched commit of the ref.
+func refCommit(r *git.Repository, name plumbing.ReferenceName) (*object.Commit, error) {
+	ref, err := r.Reference(localRefName(name), true)
+	if err != nil {
+		return nil, err
+	}
+	// Annotated tags point to a tag object instead of a commit.
+	if tag, err := r.TagObject(ref.Hash()); err == nil {
+		return tag.Commit()
+	}
+	return r.CommitObject(ref.Hash())
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+func TestResolveRefs(t *testing.T) {
+	hash := plumbing.NewHash("0123456789abcdef0123456789abcdef01234567")
+	remoteRefs := []*plumbing.Reference{
+		plumbing.NewSymbolicReference(plumbing.HEAD, "refs/heads/main"),
+		plumbing.NewHashReference("refs/heads/main", hash),
+		plumbing.NewHashReference("refs/heads/release/1.0", hash),
+		plumbing.NewHashReference("refs/heads/release/2.0", hash),
+		plumbing.NewHashReference("refs/tags/v1.0", hash),
+		plumbing.NewHashReference("refs/tags/main", hash),
+		plumbing.NewHashReference("refs/pull/1/head", hash),
+	}
+
+	tests := []struct {
+		name     string
+		patterns []string
+		want     []plumbing.ReferenceName
+		wantErr  bool
+	}{
+		{
+			name:     "short name matches branches and tags",
+			patterns: []string{"main"},
+			want:     []plumbing.ReferenceName{"refs/heads/main", "refs/tags/main"},
+		},
+		{
+			name:     "full name",
+			patterns: []string{"refs/heads/main"},
+			want:     []plumbing.ReferenceName{"refs/heads/main"},
+		},
+		{
+			name:     "patterns",
+			patterns: []string{"release/*", "refs/tags/v*"},
+			want:     []plumbing.ReferenceName{"refs/heads/release/1.0", "refs/heads/release/2.0", "refs/tags/v1.0"},
+		},
+		{
+			name:     "refs matching several patterns are resolved once",
+			patterns: []string{"release/1.0", "release/*"},
+			want:     []plumbing.ReferenceName{"refs/heads/release/1.0", "refs/heads/release/2.0"},
+		},
+		{
+			name:     "only branches and tags",
+			patterns: []string{"refs/pull/*/head", "HEAD"},
+			want:     []plumbing.ReferenceName{},
+		},
+		{
+			name:     "invalid pattern",
+			patterns: []string{"release/["},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveRefs(remoteRefs, tt.patterns)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveRefs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveRefs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocalRefName(t *testing.T) {
+	tests := []struct {
+		name plumbing.ReferenceName
+		want plumbing.ReferenceName
+	}{
+		{name: "refs/heads/main", want: "refs/remotes/origin/main"},
+		{name: "refs/heads/release/1.0", want: "refs/remotes/origin/release/1.0"},
+		{name: "refs/tags/v1.0", want: "refs/tags/v1.0"},
+	}
+	for _, tt := range tests {
+		if got := localRefName(tt.name); got != tt.want {
+			t.Errorf("localRefName(%s) = %s, want %s", tt.name, got, tt.want)
+		}
+		if got, want := fetchRefSpec(tt.name), "+"+tt.name.String()+":"+tt.want.String(); got.String() != want {
+			t.Errorf("fetchRefSpec(%s) = %s, want %s", tt.name, got, want)
+		}
+	}
+}
@@ -19,6 +19,7 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -29,13 +30,21 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/embedder"
 	"github.com/kserve/kserve/pkg/apis/serving/v1beta1"
 )
 
+const (
+	// externalModelProbeInterval is how often the endpoints of external models are probed.
+	externalModelProbeInterval = time.Minute
+	// externalModelProbeTimeout is the timeout of a probe of an external model.
+	externalModelProbeTimeout = 10 * time.Second
+)
+
 // ModelReconciler reconciles a Model object
 type ModelReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme             *runtime.Scheme
 	ModelDeployerImage string
 }
 
@@ -43,6 +52,7 @@ type ModelReconciler struct {
 //+kubebuilder:rbac:groups=cloud.encoder.run,resources=models/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=cloud.encoder.run,resources=models/finalizers,verbs=update
 //+kubebuilder:rbac:groups=serving.kserve.io,resources=inferenceservices,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.16.0/pkg/reconcile
@@ -65,6 +75,24 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// OpenAI compatible models are served outside of the cluster.
+	if model.Spec.Type == v1alpha1.ModelTypeOpenAI {
+		if err := r.ensureOpenAIStatus(ctx, model); err != nil {
+			log.Error(err, "unable to ensure status")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// External models are probed periodically since they are not owned by the operator.
+	if model.Spec.Type == v1alpha1.ModelTypeExternal {
+		if err := r.ensureExternalStatus(ctx, model); err != nil {
+			log.Error(err, "unable to ensure status")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: externalModelProbeInterval}, nil
+	}
+
 	if err := r.ensureInferenceService(ctx, model); err != nil {
 		log.Error(err, "unable to ensure deployment")
 		return ctrl.Result{}, err
@@ -213,6 +241,63 @@ func (r *ModelReconciler) ensureStatus(ctx context.Context, model v1alpha1.Model
 	return nil
 }
 
+// ensureOpenAIStatus ensures that OpenAI compatible models are ready since there is
+// nothing to deploy.
+func (r *ModelReconciler) ensureOpenAIStatus(ctx context.Context, model v1alpha1.Model) error {
+	if model.Status.State != nil && *model.Status.State == v1alpha1.ModelStateReady {
+		return nil
+	}
+
+	// Update the status of the model.
+	state := v1alpha1.ModelStateReady
+	model.Status.State = &state
+	// Add condition to the model.
+	model.Status.Conditions = append(model.Status.Conditions, metav1.Condition{
+		Type:               string(v1alpha1.ModelStateReady),
+		Status:             metav1.ConditionTrue,
+		Reason:             "OpenAIModel",
+		Message:            "Model is served by an OpenAI compatible API",
+		LastTransitionTime: metav1.Now(),
+	})
+	return r.Status().Update(ctx, &model)
+}
+
+// ensureExternalStatus ensures that the status of an external model is updated based
+// on the result of probing its endpoint.
+func (r *ModelReconciler) ensureExternalStatus(ctx context.Context, model v1alpha1.Model) error {
+	log := log.FromContext(ctx)
+
+	state := v1alpha1.ModelStateReady
+	reason := "EndpointReady"
+	message := "Endpoint returned embeddings"
+	c, err := embedder.NewModelClient(ctx, r.Client, &model)
+	if err == nil {
+		err = c.Probe(externalModelProbeTimeout)
+	}
+	if err != nil {
+		log.Info("External model probe failed", "Model.Namespace", model.Namespace, "Model.Name", model.Name, "error", err.Error())
+		state = v1alpha1.ModelStateError
+		reason = "EndpointProbeFailed"
+		message = err.Error()
+	}
+
+	if model.Status.State != nil && *model.Status.State == state {
+		return nil
+	}
+
+	// Update the status of the model.
+	model.Status.State = &state
+	// Add condition to the model.
+	model.Status.Conditions = append(model.Status.Conditions, metav1.Condition{
+		Type:               string(state),
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+	return r.Status().Update(ctx, &model)
+}
+
 // createInferenceService creates the inference service for the model.
 func (r *ModelReconciler) createInferenceService(ctx context.Context, model v1alpha1.Model) error {
 	if model.Spec.Type == v1alpha1.ModelTypeHuggingFace {
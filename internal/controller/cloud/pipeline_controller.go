@@ -101,6 +101,21 @@ func (r *PipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		}
 	}
 
+	// Create the scheduled executions
+	requeueAfter, err := r.reconcileSchedule(ctx, &pipeline, pipelineExecutions.Items)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Delete the finished executions beyond the history of the pipeline
+	expiresAfter, err := r.reconcileHistory(ctx, &pipeline, pipelineExecutions.Items)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if expiresAfter > 0 && (requeueAfter == 0 || expiresAfter < requeueAfter) {
+		requeueAfter = expiresAfter
+	}
+
 	// Update status of the Pipeline
 	pipeline.Status.State = &pipelineStatus
 	if err := r.Status().Update(ctx, &pipeline); err != nil {
@@ -108,7 +123,7 @@ func (r *PipelineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -0,0 +1,103 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+// reconcileHistory deletes the finished executions of the pipeline older than the TTL
+// of its execution history and the oldest ones beyond its limits if set, their jobs
+// are deleted with them. It returns the time until the next finished execution expires.
+func (r *PipelineReconciler) reconcileHistory(ctx context.Context, pipeline *v1alpha1.Pipeline, executions []v1alpha1.PipelineExecution) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	history := pipeline.Spec.ExecutionHistory
+	ttl, expires := history.TTL()
+	now := time.Now()
+
+	var expiresAfter time.Duration
+	var successful, failed []v1alpha1.PipelineExecution
+	for _, exec := range executions {
+		if exec.DeletionTimestamp != nil || !exec.Finished() {
+			continue
+		}
+		if expires {
+			if remaining := executionFinishTime(&exec).Add(ttl).Sub(now); remaining <= 0 {
+				logger.Info("Deleting execution since its TTL passed", "execution", exec.Name)
+				if err := r.deleteExecution(ctx, &exec); err != nil {
+					return 0, err
+				}
+				continue
+			} else if expiresAfter == 0 || remaining < expiresAfter {
+				expiresAfter = remaining
+			}
+		}
+		if *exec.Status.State == v1alpha1.PipelineExecutionStateSucceeded {
+			successful = append(successful, exec)
+		} else {
+			failed = append(failed, exec)
+		}
+	}
+
+	successfulLimit, limitSuccessful := history.SuccessfulLimit()
+	failedLimit, limitFailed := history.FailedLimit()
+	for _, kept := range []struct {
+		executions []v1alpha1.PipelineExecution
+		limit      int32
+		limited    bool
+	}{{successful, successfulLimit, limitSuccessful}, {failed, failedLimit, limitFailed}} {
+		if !kept.limited {
+			continue
+		}
+		sort.Slice(kept.executions, func(i, j int) bool {
+			return kept.executions[i].CreationTimestamp.Before(&kept.executions[j].CreationTimestamp)
+		})
+		for i := 0; i < len(kept.executions)-int(kept.limit); i++ {
+			logger.Info("Deleting execution beyond the history limit", "execution", kept.executions[i].Name)
+			if err := r.deleteExecution(ctx, &kept.executions[i]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return expiresAfter, nil
+}
+
+// deleteExecution deletes the execution and its jobs.
+func (r *PipelineReconciler) deleteExecution(ctx context.Context, exec *v1alpha1.PipelineExecution) error {
+	if err := r.Delete(ctx, exec, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("error deleting pipeline execution: %w", err)
+	}
+	return nil
+}
+
+// executionFinishTime returns the time the execution finished. Executions finished
+// before the completion time was recorded are timed by their creation.
+func executionFinishTime(exec *v1alpha1.PipelineExecution) time.Time {
+	if exec.Status.CompletionTime != nil {
+		return exec.Status.CompletionTime.Time
+	}
+	return exec.CreationTimestamp.Time
+}
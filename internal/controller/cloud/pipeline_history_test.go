@@ -0,0 +1,100 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+var _ = Describe("Pipeline execution history", func() {
+	ctx := context.Background()
+	var reconciler *PipelineReconciler
+
+	BeforeEach(func() {
+		reconciler = &PipelineReconciler{Client: k8sClient, Scheme: scheme.Scheme}
+	})
+
+	It("should delete the oldest finished executions beyond the limits", func() {
+		successfulLimit, failedLimit := int32(0), int32(1)
+		pipeline := createPipeline(ctx, "", nil)
+		pipeline.Spec.ExecutionHistory = &v1alpha1.ExecutionHistory{
+			SuccessfulExecutionsLimit: &successfulLimit,
+			FailedExecutionsLimit:     &failedLimit,
+		}
+		Expect(k8sClient.Update(ctx, pipeline)).To(Succeed())
+
+		succeeded := finishExecution(ctx, createExecution(ctx, pipeline, "a"), v1alpha1.PipelineExecutionStateSucceeded, time.Now())
+		failed := finishExecution(ctx, createExecution(ctx, pipeline, "b"), v1alpha1.PipelineExecutionStateFailed, time.Now())
+		running := createExecution(ctx, pipeline, "c")
+
+		expiresAfter, err := reconciler.reconcileHistory(ctx, pipeline, []v1alpha1.PipelineExecution{*succeeded, *failed, *running})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiresAfter).To(BeZero())
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(succeeded), &v1alpha1.PipelineExecution{}))).To(BeTrue())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(failed), &v1alpha1.PipelineExecution{})).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(running), &v1alpha1.PipelineExecution{})).To(Succeed())
+	})
+
+	It("should keep the finished executions without an execution history", func() {
+		pipeline := createPipeline(ctx, "", nil)
+		succeeded := finishExecution(ctx, createExecution(ctx, pipeline, "a"), v1alpha1.PipelineExecutionStateSucceeded, time.Now().Add(-time.Hour))
+		failed := finishExecution(ctx, createExecution(ctx, pipeline, "b"), v1alpha1.PipelineExecutionStateFailed, time.Now().Add(-time.Hour))
+
+		expiresAfter, err := reconciler.reconcileHistory(ctx, pipeline, []v1alpha1.PipelineExecution{*succeeded, *failed})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiresAfter).To(BeZero())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(succeeded), &v1alpha1.PipelineExecution{})).To(Succeed())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(failed), &v1alpha1.PipelineExecution{})).To(Succeed())
+	})
+
+	It("should delete the finished executions once their TTL passed", func() {
+		ttl := int32(60)
+		pipeline := createPipeline(ctx, "", nil)
+		pipeline.Spec.ExecutionHistory = &v1alpha1.ExecutionHistory{TTLSecondsAfterFinished: &ttl}
+		Expect(k8sClient.Update(ctx, pipeline)).To(Succeed())
+
+		expired := finishExecution(ctx, createExecution(ctx, pipeline, "a"), v1alpha1.PipelineExecutionStateSucceeded, time.Now().Add(-2*time.Minute))
+		recent := finishExecution(ctx, createExecution(ctx, pipeline, "b"), v1alpha1.PipelineExecutionStateSucceeded, time.Now())
+
+		expiresAfter, err := reconciler.reconcileHistory(ctx, pipeline, []v1alpha1.PipelineExecution{*expired, *recent})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(expiresAfter).To(BeNumerically("~", time.Minute, 5*time.Second))
+		Expect(errors.IsNotFound(k8sClient.Get(ctx, client.ObjectKeyFromObject(expired), &v1alpha1.PipelineExecution{}))).To(BeTrue())
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(recent), &v1alpha1.PipelineExecution{})).To(Succeed())
+	})
+})
+
+// finishExecution sets the final state and completion time of the execution.
+func finishExecution(ctx context.Context, pe *v1alpha1.PipelineExecution, state v1alpha1.PipelineExecutionState, completion time.Time) *v1alpha1.PipelineExecution {
+	GinkgoHelper()
+	completionTime := metav1.NewTime(completion)
+	pe.Status.State = &state
+	pe.Status.CompletionTime = &completionTime
+	Expect(k8sClient.Status().Update(ctx, pe)).To(Succeed())
+	return pe
+}
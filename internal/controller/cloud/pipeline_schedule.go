@@ -0,0 +1,130 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/schedule"
+)
+
+// reconcileSchedule creates the execution of the latest missed run of the schedule of
+// the pipeline. It returns the time until the next run.
+func (r *PipelineReconciler) reconcileSchedule(ctx context.Context, pipeline *v1alpha1.Pipeline, executions []v1alpha1.PipelineExecution) (time.Duration, error) {
+	logger := log.FromContext(ctx)
+	spec := pipeline.Spec.Schedule
+	if spec == nil || !pipeline.Spec.Enabled {
+		return 0, nil
+	}
+
+	sched, err := schedule.Parse(spec)
+	if err != nil {
+		// The schedule is fixed by updating the pipeline, so it is not retried.
+		logger.Error(err, "Invalid schedule")
+		return 0, nil
+	}
+
+	now := time.Now()
+	missed, next := missedRun(pipeline, sched, now)
+	requeueAfter := next.Sub(now)
+	if missed.IsZero() {
+		return requeueAfter, nil
+	}
+
+	if spec.StartingDeadlineSeconds != nil && missed.Add(time.Duration(*spec.StartingDeadlineSeconds)*time.Second).Before(now) {
+		logger.Info("Missed the starting deadline of the scheduled execution", "scheduledAt", missed)
+		return requeueAfter, nil
+	}
+
+	// The other policies are applied by the executions. With Forbid, the missed run is
+	// started once the running executions finished, unless the starting deadline passed
+	// by then.
+	if pipeline.Spec.ConcurrencyPolicy == v1alpha1.ConcurrencyPolicyForbid {
+		for _, exec := range executions {
+			if !exec.Finished() {
+				logger.Info("Postponing the scheduled execution since executions are running", "scheduledAt", missed)
+				return requeueAfter, nil
+			}
+		}
+	}
+
+	execution := scheduledExecution(pipeline, missed)
+	if err := r.Create(ctx, execution); err != nil && !apierrors.IsAlreadyExists(err) {
+		return 0, fmt.Errorf("error creating scheduled pipeline execution: %w", err)
+	}
+	logger.Info("Created scheduled execution", "execution", execution.Name, "scheduledAt", missed)
+	pipeline.Status.LastScheduleTime = &metav1.Time{Time: missed}
+	return requeueAfter, nil
+}
+
+// missedRun returns the latest run of the schedule since the last scheduled execution
+// that is not after now, or zero if there is none, and the next run after now.
+func missedRun(pipeline *v1alpha1.Pipeline, sched cron.Schedule, now time.Time) (time.Time, time.Time) {
+	earliest := pipeline.CreationTimestamp.Time
+	if pipeline.Status.LastScheduleTime != nil {
+		earliest = pipeline.Status.LastScheduleTime.Time
+	}
+	if deadline := pipeline.Spec.Schedule.StartingDeadlineSeconds; deadline != nil {
+		// Runs before the deadline can't be started anyway.
+		if start := now.Add(-time.Duration(*deadline) * time.Second); start.After(earliest) {
+			earliest = start
+		}
+	}
+
+	var missed time.Time
+	t := sched.Next(earliest)
+	for !t.After(now) {
+		missed = t
+		t = sched.Next(t)
+	}
+	return missed, t
+}
+
+// scheduledExecution returns the execution of the run of the schedule. The name is
+// derived from the time of the run so the run is created once.
+func scheduledExecution(pipeline *v1alpha1.Pipeline, scheduledAt time.Time) *v1alpha1.PipelineExecution {
+	return &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", pipeline.Name, scheduledAt.Unix()/60),
+			Namespace: pipeline.Namespace,
+			Labels: map[string]string{
+				"pipelineId": pipeline.Name,
+			},
+			Annotations: map[string]string{
+				v1alpha1.ScheduledAtAnnotation: scheduledAt.Format(time.RFC3339),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(pipeline, v1alpha1.GroupVersion.WithKind("Pipeline")),
+			},
+		},
+		Spec: v1alpha1.PipelineExecutionSpec{
+			PipelineRef: v1.ObjectReference{
+				Name:      pipeline.Name,
+				Namespace: pipeline.Namespace,
+			},
+		},
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/schedule"
+)
+
+func TestMissedRun(t *testing.T) {
+	created := time.Date(2024, time.March, 10, 9, 15, 0, 0, time.UTC)
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, time.March, 10, hour, minute, 0, 0, time.UTC)
+	}
+	deadline := func(seconds int64) *int64 { return &seconds }
+
+	tests := []struct {
+		name             string
+		cron             string
+		lastScheduleTime *time.Time
+		deadline         *int64
+		now              time.Time
+		wantMissed       time.Time
+		wantNext         time.Time
+	}{
+		{
+			name:     "no run since the creation",
+			cron:     "0 * * * *",
+			now:      at(9, 45),
+			wantNext: at(10, 0),
+		},
+		{
+			name:       "first run since the creation",
+			cron:       "0 * * * *",
+			now:        at(10, 5),
+			wantMissed: at(10, 0),
+			wantNext:   at(11, 0),
+		},
+		{
+			name:       "run due right now",
+			cron:       "0 * * * *",
+			now:        at(10, 0),
+			wantMissed: at(10, 0),
+			wantNext:   at(11, 0),
+		},
+		{
+			name:       "latest of several missed runs",
+			cron:       "0 * * * *",
+			now:        at(13, 30),
+			wantMissed: at(13, 0),
+			wantNext:   at(14, 0),
+		},
+		{
+			name:             "run already scheduled",
+			cron:             "0 * * * *",
+			lastScheduleTime: ptrTime(at(13, 0)),
+			now:              at(13, 30),
+			wantNext:         at(14, 0),
+		},
+		{
+			name:             "run since the last scheduled run",
+			cron:             "0 * * * *",
+			lastScheduleTime: ptrTime(at(12, 0)),
+			now:              at(13, 30),
+			wantMissed:       at(13, 0),
+			wantNext:         at(14, 0),
+		},
+		{
+			name:       "missed run within the starting deadline",
+			cron:       "0 * * * *",
+			deadline:   deadline(3600),
+			now:        at(13, 30),
+			wantMissed: at(13, 0),
+			wantNext:   at(14, 0),
+		},
+		{
+			name:     "missed run past the starting deadline",
+			cron:     "0 * * * *",
+			deadline: deadline(600),
+			now:      at(13, 30),
+			wantNext: at(14, 0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline := &v1alpha1.Pipeline{
+				ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+				Spec: v1alpha1.PipelineSpec{
+					Schedule: &v1alpha1.ScheduleSpec{Cron: tt.cron, StartingDeadlineSeconds: tt.deadline},
+				},
+			}
+			if tt.lastScheduleTime != nil {
+				pipeline.Status.LastScheduleTime = &metav1.Time{Time: *tt.lastScheduleTime}
+			}
+			sched, err := schedule.Parse(pipeline.Spec.Schedule)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			missed, next := missedRun(pipeline, sched, tt.now)
+			if !missed.Equal(tt.wantMissed) {
+				t.Errorf("missedRun() missed = %v, want %v", missed, tt.wantMissed)
+			}
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("missedRun() next = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+// cancel stops an execution that was requested to be cancelled. The job of the running
+// attempt is deleted, its pod gets the termination grace period to finish the batch it
+// is saving. Finished executions are kept as they are.
+func (r *PipelineExecutionReconciler) cancel(ctx context.Context, pe *v1alpha1.PipelineExecution) error {
+	if pe.Finished() {
+		return nil
+	}
+
+	now := metav1.Now()
+	if attempt := lastAttempt(pe); attempt != nil && attempt.State == v1alpha1.PipelineExecutionStateActive {
+		for _, name := range []string{attempt.JobName, attempt.FinalizeJobName} {
+			if name == "" {
+				continue
+			}
+			log.FromContext(ctx).Info("Deleting the job of the cancelled execution", "job", name)
+			job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: pe.Namespace}}
+			if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+				return fmt.Errorf("error deleting job: %w", err)
+			}
+		}
+		attempt.State = v1alpha1.PipelineExecutionStateCancelled
+		attempt.CompletionTime = &now
+	}
+
+	state := v1alpha1.PipelineExecutionStateCancelled
+	pe.Status.State = &state
+	pe.Status.CompletionTime = &now
+	pe.Status.NextAttemptTime = nil
+	pe.Status.Message = "the execution was cancelled"
+	return r.Status().Update(ctx, pe)
+}
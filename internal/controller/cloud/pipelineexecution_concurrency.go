@@ -0,0 +1,260 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+// admit applies the concurrency policy of the pipeline to an execution that was not
+// started yet. It returns true if the job of the execution can be created, otherwise
+// the execution is kept pending or skipped.
+func (r *PipelineExecutionReconciler) admit(ctx context.Context, pe *v1alpha1.PipelineExecution, pipeline *v1alpha1.Pipeline) (bool, error) {
+	logger := log.FromContext(ctx)
+	// Pipelines created before the policy was added have none.
+	policy := pipeline.Spec.ConcurrencyPolicy
+	if policy == "" || policy == v1alpha1.ConcurrencyPolicyAllow {
+		return true, nil
+	}
+
+	running, err := r.runningExecutions(ctx, pe)
+	if err != nil {
+		return false, err
+	}
+
+	switch policy {
+	case v1alpha1.ConcurrencyPolicyForbid:
+		if len(running) > 0 {
+			logger.Info("Skipping execution since executions of the pipeline are running")
+			return false, r.skip(ctx, pe, v1alpha1.AdmissionReasonForbidden, fmt.Sprintf("execution %s of the pipeline is running", running[0]))
+		}
+	case v1alpha1.ConcurrencyPolicyReplace:
+		// Executions waiting for the executions they replaced are replaced as well.
+		queued, err := r.queuedBefore(ctx, pe)
+		if err != nil {
+			return false, err
+		}
+		for _, exec := range queued {
+			running = append(running, exec.Name)
+		}
+		for _, name := range running {
+			// The jobs of the execution are deleted with it.
+			logger.Info("Deleting the running execution to replace it", "execution", name)
+			replaced := &v1alpha1.PipelineExecution{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: pe.Namespace}}
+			if err := r.Delete(ctx, replaced, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+				return false, fmt.Errorf("error deleting pipeline execution: %w", err)
+			}
+		}
+		// The pods of the replaced executions keep writing embeddings until they
+		// terminated, the execution starts once they did.
+		pods, err := r.activePods(ctx, pe)
+		if err != nil {
+			return false, err
+		}
+		if len(pods) > 0 {
+			return false, r.queue(ctx, pe, fmt.Sprintf("waiting for pod %s of a replaced execution to terminate", pods[0]))
+		}
+	default:
+		// Executions queued before this one index its changes as well.
+		queued, err := r.queuedBefore(ctx, pe)
+		if err != nil {
+			return false, err
+		}
+		if len(queued) > 0 {
+			logger.Info("Skipping execution since another execution is queued", "execution", queued[0].Name)
+			return false, r.skip(ctx, pe, v1alpha1.AdmissionReasonDuplicate, fmt.Sprintf("execution %s of the pipeline is queued", queued[0].Name))
+		}
+		if len(running) > 0 {
+			return false, r.queue(ctx, pe, fmt.Sprintf("waiting for execution %s of the pipeline", running[0]))
+		}
+	}
+	return true, nil
+}
+
+// runningExecutions returns the names of the other executions of the pipeline with an
+// unfinished job or started and not finished yet.
+func (r *PipelineExecutionReconciler) runningExecutions(ctx context.Context, pe *v1alpha1.PipelineExecution) ([]string, error) {
+	running := make(map[string]bool)
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, client.InNamespace(pe.Namespace), client.MatchingLabels{"pipelineId": pe.Spec.PipelineRef.Name}); err != nil {
+		return nil, fmt.Errorf("error listing jobs: %w", err)
+	}
+	jobNames := make(map[string]bool, len(jobs.Items))
+	for _, job := range jobs.Items {
+		jobNames[job.Name] = true
+	}
+	for _, job := range jobs.Items {
+		if job.DeletionTimestamp != nil || jobFailed(&job) {
+			continue
+		}
+		// The execution of a sharded job runs until its finalize job finished.
+		sharded := job.Spec.CompletionMode != nil && *job.Spec.CompletionMode == batchv1.IndexedCompletion
+		if jobSucceeded(&job) && (!sharded || jobNames[job.Name+"-finalize"]) {
+			continue
+		}
+		// Jobs created before the label was set are named after their execution.
+		name, ok := job.Labels[v1alpha1.ExecutionJobLabel]
+		if !ok {
+			name = job.Name
+		}
+		running[name] = true
+	}
+
+	executions := &v1alpha1.PipelineExecutionList{}
+	if err := r.List(ctx, executions, client.InNamespace(pe.Namespace), client.MatchingLabels{"pipelineId": pe.Spec.PipelineRef.Name}); err != nil {
+		return nil, fmt.Errorf("error listing pipeline executions: %w", err)
+	}
+	// Executions started are running until their status is finished, even if the cache
+	// doesn't have their job yet or they wait to retry a failed attempt.
+	for _, exec := range executions.Items {
+		if len(exec.Status.Attempts) > 0 && !exec.Finished() {
+			running[exec.Name] = true
+		}
+	}
+
+	delete(running, pe.Name)
+	names := make([]string, 0, len(running))
+	for name := range running {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// queuedBefore returns the executions of the pipeline created before the execution
+// that wait for the running executions, oldest first.
+func (r *PipelineExecutionReconciler) queuedBefore(ctx context.Context, pe *v1alpha1.PipelineExecution) ([]*v1alpha1.PipelineExecution, error) {
+	executions := &v1alpha1.PipelineExecutionList{}
+	if err := r.List(ctx, executions, client.InNamespace(pe.Namespace), client.MatchingLabels{"pipelineId": pe.Spec.PipelineRef.Name}); err != nil {
+		return nil, fmt.Errorf("error listing pipeline executions: %w", err)
+	}
+	queued := make([]*v1alpha1.PipelineExecution, 0)
+	for i := range executions.Items {
+		exec := &executions.Items[i]
+		if exec.Name == pe.Name || !executionQueued(exec) || !createdBefore(exec, pe) {
+			continue
+		}
+		queued = append(queued, exec)
+	}
+	sort.Slice(queued, func(i, j int) bool {
+		return createdBefore(queued[i], queued[j])
+	})
+	return queued, nil
+}
+
+// activePods returns the names of the pods of the other executions of the pipeline
+// that did not terminate yet, including the pods of deleted jobs.
+func (r *PipelineExecutionReconciler) activePods(ctx context.Context, pe *v1alpha1.PipelineExecution) ([]string, error) {
+	pods := &v1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(pe.Namespace), client.MatchingLabels{"pipelineId": pe.Spec.PipelineRef.Name}); err != nil {
+		return nil, fmt.Errorf("error listing pods: %w", err)
+	}
+	names := make([]string, 0)
+	for _, pod := range pods.Items {
+		if pod.Labels[v1alpha1.ExecutionJobLabel] == pe.Name || pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+		names = append(names, pod.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// executionQueued returns true if the execution waits for the running executions.
+func executionQueued(exec *v1alpha1.PipelineExecution) bool {
+	cond := meta.FindStatusCondition(exec.Status.Conditions, v1alpha1.PipelineExecutionConditionAdmitted)
+	return cond != nil && cond.Reason == v1alpha1.AdmissionReasonQueued && !exec.Finished()
+}
+
+// createdBefore orders executions by their creation, executions created in the same
+// second are ordered by name.
+func createdBefore(a, b *v1alpha1.PipelineExecution) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
+
+// queue keeps the execution pending until the running executions finished.
+func (r *PipelineExecutionReconciler) queue(ctx context.Context, pe *v1alpha1.PipelineExecution, message string) error {
+	cond := meta.FindStatusCondition(pe.Status.Conditions, v1alpha1.PipelineExecutionConditionAdmitted)
+	if executionQueued(pe) && cond.Message == message {
+		return nil
+	}
+	state := v1alpha1.PipelineExecutionStatePending
+	pe.Status.State = &state
+	meta.SetStatusCondition(&pe.Status.Conditions, metav1.Condition{
+		Type:    v1alpha1.PipelineExecutionConditionAdmitted,
+		Status:  metav1.ConditionFalse,
+		Reason:  v1alpha1.AdmissionReasonQueued,
+		Message: message,
+	})
+	return r.Status().Update(ctx, pe)
+}
+
+// skip marks the execution as skipped, it is never started.
+func (r *PipelineExecutionReconciler) skip(ctx context.Context, pe *v1alpha1.PipelineExecution, reason, message string) error {
+	state := v1alpha1.PipelineExecutionStateSkipped
+	now := metav1.Now()
+	pe.Status.State = &state
+	pe.Status.CompletionTime = &now
+	pe.Status.Message = message
+	meta.SetStatusCondition(&pe.Status.Conditions, metav1.Condition{
+		Type:    v1alpha1.PipelineExecutionConditionAdmitted,
+		Status:  metav1.ConditionFalse,
+		Reason:  reason,
+		Message: message,
+	})
+	return r.Status().Update(ctx, pe)
+}
+
+// queuedExecutions maps a job, a pod or an execution to the queued executions of its
+// pipeline, so they are started once the job, the pod or the execution finished.
+func (r *PipelineExecutionReconciler) queuedExecutions(ctx context.Context, obj client.Object) []reconcile.Request {
+	pipelineId, ok := obj.GetLabels()["pipelineId"]
+	if !ok {
+		return nil
+	}
+	executions := &v1alpha1.PipelineExecutionList{}
+	if err := r.List(ctx, executions, client.InNamespace(obj.GetNamespace()), client.MatchingLabels{"pipelineId": pipelineId}); err != nil {
+		log.FromContext(ctx).Error(err, "unable to list pipeline executions")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0)
+	for i := range executions.Items {
+		if executionQueued(&executions.Items[i]) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      executions.Items[i].Name,
+				Namespace: executions.Items[i].Namespace,
+			}})
+		}
+	}
+	return requests
+}
@@ -19,10 +19,12 @@ package cloud
 import (
 	"context"
 	"fmt"
+	"time"
 
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -30,6 +32,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/encoder-run/operator/api/cloud/v1alpha1"
@@ -47,6 +50,7 @@ type PipelineExecutionReconciler struct {
 //+kubebuilder:rbac:groups=cloud.encoder.run,resources=pipelineexecutions/finalizers,verbs=update
 //+kubebuilder:rbac:groups=cloud.encoder.run,resources=pipelines,verbs=get;list;watch
 //+kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.16.0/pkg/reconcile
@@ -59,6 +63,15 @@ func (r *PipelineExecutionReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
 
+	// Cancelled executions are stopped even if their pipeline is gone.
+	if pe.Spec.Cancelled {
+		if err := r.cancel(ctx, &pe); err != nil {
+			log.Error(err, "unable to cancel execution")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	var pipeline v1alpha1.Pipeline
 	if err := r.Get(ctx, types.NamespacedName{Name: pe.Spec.PipelineRef.Name, Namespace: pe.Namespace}, &pipeline); err != nil {
 		log.Error(err, "unable to fetch Pipeline")
@@ -78,96 +91,258 @@ func (r *PipelineExecutionReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, nil
 	}
 
+	// Skipped executions are never started.
+	if pe.Status.State != nil && *pe.Status.State == v1alpha1.PipelineExecutionStateSkipped {
+		return ctrl.Result{}, nil
+	}
+
 	if err := r.ensureJob(ctx, &pe, &pipeline); err != nil {
 		log.Error(err, "unable to ensure job")
 		return ctrl.Result{}, err
 	}
 
-	if err := r.ensureStatus(ctx, &pe); err != nil {
+	requeueAfter, err := r.ensureStatus(ctx, &pe, &pipeline)
+	if err != nil {
 		log.Error(err, "unable to update status")
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
-// ensureJob checks if a Job for the PipelineExecution has been created;
-// if not, it creates one.
+// ensureJob creates the job of the first attempt of the PipelineExecution once the
+// concurrency policy of the pipeline admits it, and the job of the next attempt once
+// the backoff of the failed attempt passed. Finished executions are never started again.
 func (r *PipelineExecutionReconciler) ensureJob(ctx context.Context, pe *v1alpha1.PipelineExecution, pipeline *v1alpha1.Pipeline) error {
-	job := &batchv1.Job{}
-	jobName := pe.Name
-	err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: pe.Namespace}, job)
-	if err != nil && !errors.IsNotFound(err) {
+	if pe.Finished() {
+		return nil
+	}
+	attempt := lastAttempt(pe)
+	if attempt == nil {
+		admitted, err := r.admit(ctx, pe, pipeline)
+		if err != nil || !admitted {
+			return err
+		}
+		return r.startAttempt(ctx, pe, pipeline, 1)
+	}
+	if pe.Status.NextAttemptTime != nil && !time.Now().Before(pe.Status.NextAttemptTime.Time) {
+		return r.startAttempt(ctx, pe, pipeline, attempt.Attempt+1)
+	}
+	return nil
+}
+
+// startAttempt creates the job of the attempt and records the attempt. The job of a
+// sharded execution is an indexed job with a pod per shard.
+func (r *PipelineExecutionReconciler) startAttempt(ctx context.Context, pe *v1alpha1.PipelineExecution, pipeline *v1alpha1.Pipeline, attempt int32) error {
+	args := embedderArgs(pe, pipeline)
+	shards := pipeline.Spec.RepositoryEmbeddings.ShardCount()
+	if shards > 1 {
+		// The index of the pod is set by the job controller.
+		args = append(args, "--shard=$(JOB_COMPLETION_INDEX)", fmt.Sprintf("--shards=%d", shards))
+	}
+	job := r.embedderJob(pe, pipeline, attemptJobName(pe, attempt), args)
+	if shards > 1 {
+		completionMode := batchv1.IndexedCompletion
+		job.Spec.CompletionMode = &completionMode
+		job.Spec.Completions = ptr.Int32(int32(shards))
+		job.Spec.Parallelism = ptr.Int32(int32(shards))
+	}
+	if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
 		return err
 	}
-	if errors.IsNotFound(err) {
-		// Define the job
-		job = &batchv1.Job{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      jobName,
-				Namespace: pe.Namespace,
-				Labels: map[string]string{
-					"pipelineId": pe.Spec.PipelineRef.Name,
-				},
+
+	now := metav1.Now()
+	if pe.Status.StartTime == nil {
+		pe.Status.StartTime = &now
+	}
+	pe.Status.Attempts = append(pe.Status.Attempts, v1alpha1.ExecutionAttempt{
+		Attempt:   attempt,
+		JobName:   job.Name,
+		State:     v1alpha1.PipelineExecutionStateActive,
+		StartTime: now,
+	})
+	pe.Status.NextAttemptTime = nil
+	// The progress and the stats of the shards are reported again by the embedders of the attempt.
+	pe.Status.Progress = nil
+	pe.Status.ShardProgress = nil
+	pe.Status.ShardStats = nil
+	state := v1alpha1.PipelineExecutionStateActive
+	pe.Status.State = &state
+	meta.SetStatusCondition(&pe.Status.Conditions, metav1.Condition{
+		Type:   v1alpha1.PipelineExecutionConditionAdmitted,
+		Status: metav1.ConditionTrue,
+		Reason: v1alpha1.AdmissionReasonStarted,
+	})
+	return r.Status().Update(ctx, pe)
+}
+
+// startFinalize creates the job recording the indexed commits of a sharded attempt
+// whose shards all succeeded.
+func (r *PipelineExecutionReconciler) startFinalize(ctx context.Context, pe *v1alpha1.PipelineExecution, pipeline *v1alpha1.Pipeline, attempt *v1alpha1.ExecutionAttempt) error {
+	args := append(embedderArgs(pe, pipeline), "--finalize")
+	job := r.embedderJob(pe, pipeline, attempt.JobName+"-finalize", args)
+	if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	attempt.FinalizeJobName = job.Name
+	return nil
+}
+
+// embedderArgs returns the arguments of the embedder of the execution.
+func embedderArgs(pe *v1alpha1.PipelineExecution, pipeline *v1alpha1.Pipeline) []string {
+	args := []string{
+		fmt.Sprintf("--pipelineId=%s", pipeline.Name),
+		fmt.Sprintf("--executionId=%s", pe.Name),
+		fmt.Sprintf("--storageId=%s", pipeline.Spec.RepositoryEmbeddings.Storage.Name),
+		fmt.Sprintf("--repositoryId=%s", pipeline.Spec.RepositoryEmbeddings.Repository.Name),
+		fmt.Sprintf("--modelId=%s", pipeline.Spec.RepositoryEmbeddings.Model.Name),
+		fmt.Sprintf("--dimension=%d", pipeline.Spec.RepositoryEmbeddings.EmbeddingDimension()),
+	}
+	// Without a chunking spec the files are chunked by the model.
+	if chunking := pipeline.Spec.RepositoryEmbeddings.Chunking; chunking != nil {
+		args = append(args,
+			fmt.Sprintf("--chunking=%s", chunking.Strategy),
+			fmt.Sprintf("--chunkSize=%d", chunking.Size),
+			fmt.Sprintf("--chunkOverlap=%d", chunking.Overlap),
+		)
+	}
+	return args
+}
+
+// embedderJob returns the job running the embedder with the arguments.
+func (r *PipelineExecutionReconciler) embedderJob(pe *v1alpha1.PipelineExecution, pipeline *v1alpha1.Pipeline, name string, args []string) *batchv1.Job {
+	// Define the job, failed attempts are retried by the controller
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: pe.Namespace,
+			Labels: map[string]string{
+				"pipelineId":               pe.Spec.PipelineRef.Name,
+				v1alpha1.ExecutionJobLabel: pe.Name,
 			},
-			Spec: batchv1.JobSpec{
-				BackoffLimit: ptr.Int32(0),
-				Template: v1.PodTemplateSpec{
-					Spec: v1.PodSpec{
-						ServiceAccountName: "pipeline-worker",
-						Containers: []v1.Container{
-							{
-								Name:    "repoembedder-container",
-								Image:   r.RepositoryEmbedderImage,
-								Command: []string{"./main"},
-								Args: []string{
-									fmt.Sprintf("--storageId=%s", pipeline.Spec.RepositoryEmbeddings.Storage.Name),
-									fmt.Sprintf("--repositoryId=%s", pipeline.Spec.RepositoryEmbeddings.Repository.Name),
-									fmt.Sprintf("--modelId=%s", pipeline.Spec.RepositoryEmbeddings.Model.Name),
-								},
-							},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: ptr.Int32(0),
+			Template: v1.PodTemplateSpec{
+				// The pods are found by their pipeline once their job was deleted.
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"pipelineId":               pe.Spec.PipelineRef.Name,
+						v1alpha1.ExecutionJobLabel: pe.Name,
+					},
+				},
+				Spec: v1.PodSpec{
+					ServiceAccountName: "pipeline-worker",
+					// Interrupted embedders finish saving the current batch before exiting.
+					TerminationGracePeriodSeconds: ptr.Int64(120),
+					Containers: []v1.Container{
+						{
+							Name:    "repoembedder-container",
+							Image:   r.RepositoryEmbedderImage,
+							Command: []string{"./main"},
+							Args:    args,
+							// The last log lines are the failure message of the attempt.
+							TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
 						},
-						RestartPolicy: v1.RestartPolicyNever,
 					},
+					RestartPolicy: v1.RestartPolicyNever,
 				},
 			},
-		}
-		// Set PipelineExecution instance as the owner and controller
-		controllerutil.SetControllerReference(pe, job, r.Scheme)
-		err = r.Create(ctx, job)
-		if err != nil {
-			return err
-		}
+		},
 	}
-	return nil
+	applyJobTemplate(job, pipeline.Spec.JobTemplate)
+	// Set PipelineExecution instance as the owner and controller
+	controllerutil.SetControllerReference(pe, job, r.Scheme)
+	return job
+}
+
+// applyJobTemplate applies the job template of the pipeline to the job of an attempt.
+// The service account, command and arguments of the embedder are kept.
+func applyJobTemplate(job *batchv1.Job, template *v1alpha1.JobTemplate) {
+	if template == nil {
+		return
+	}
+	job.Spec.ActiveDeadlineSeconds = template.ActiveDeadlineSeconds
+
+	pod := &job.Spec.Template
+	pod.Annotations = template.Annotations
+	pod.Spec.NodeSelector = template.NodeSelector
+	pod.Spec.Tolerations = template.Tolerations
+	pod.Spec.Affinity = template.Affinity
+	pod.Spec.PriorityClassName = template.PriorityClassName
+	pod.Spec.ImagePullSecrets = template.ImagePullSecrets
+
+	container := &pod.Spec.Containers[0]
+	container.Resources = template.Resources
+	container.Env = append(container.Env, template.Env...)
 }
 
-func (r *PipelineExecutionReconciler) ensureStatus(ctx context.Context, pe *v1alpha1.PipelineExecution) error {
-	// Get the job if it exists
+// ensureStatus updates the status from the job of the last attempt. Failed attempts
+// are retried after their backoff if the retry policy of the pipeline allows it. It
+// returns the time until the next attempt.
+func (r *PipelineExecutionReconciler) ensureStatus(ctx context.Context, pe *v1alpha1.PipelineExecution, pipeline *v1alpha1.Pipeline) (time.Duration, error) {
+	attempt := lastAttempt(pe)
+	if attempt == nil {
+		return 0, nil
+	}
+	if attempt.State != v1alpha1.PipelineExecutionStateActive {
+		if pe.Status.NextAttemptTime != nil {
+			return time.Until(pe.Status.NextAttemptTime.Time), nil
+		}
+		return 0, nil
+	}
+
+	// Get the job if it exists, the finalize job once the shards of a sharded attempt succeeded
+	jobName := attempt.JobName
+	if attempt.FinalizeJobName != "" {
+		jobName = attempt.FinalizeJobName
+	}
 	job := &batchv1.Job{}
-	jobName := pe.Name
 	err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: pe.Namespace}, job)
 	if err != nil {
-		return client.IgnoreNotFound(err)
+		return 0, client.IgnoreNotFound(err)
 	}
 
-	// Update the status based on the job
-	var state v1alpha1.PipelineExecutionState
-	if job.Status.Succeeded > 0 {
+	// Update the status based on the job, a job without pods yet is active as well
+	var requeueAfter time.Duration
+	state := v1alpha1.PipelineExecutionStateActive
+	if jobSucceeded(job) && attempt.FinalizeJobName == "" && job.Spec.CompletionMode != nil && *job.Spec.CompletionMode == batchv1.IndexedCompletion {
+		if err := r.startFinalize(ctx, pe, pipeline, attempt); err != nil {
+			return 0, err
+		}
+	} else if jobSucceeded(job) {
 		state = v1alpha1.PipelineExecutionStateSucceeded
-	} else if job.Status.Failed > 0 {
-		state = v1alpha1.PipelineExecutionStateFailed
-	} else if job.Status.Active > 0 {
-		state = v1alpha1.PipelineExecutionStateActive
+		attempt.State = state
+		attempt.CompletionTime = completionTime(job)
+		pe.Status.CompletionTime = attempt.CompletionTime
+	} else if jobFailed(job) {
+		attempt.State = v1alpha1.PipelineExecutionStateFailed
+		attempt.CompletionTime = completionTime(job)
+		attempt.Reason, attempt.Message, err = r.attemptFailure(ctx, job)
+		if err != nil {
+			return 0, err
+		}
+
+		policy := pipeline.Spec.RetryPolicy
+		if policy.Retryable(attempt.Reason) && attempt.Attempt < policy.Attempts() {
+			requeueAfter = policy.Backoff(attempt.Attempt)
+			next := metav1.NewTime(time.Now().Add(requeueAfter))
+			pe.Status.NextAttemptTime = &next
+		} else {
+			state = v1alpha1.PipelineExecutionStateFailed
+			pe.Status.CompletionTime = attempt.CompletionTime
+			pe.Status.Reason = attempt.Reason
+			pe.Status.Message = attempt.Message
+		}
 	}
 	pe.Status.State = &state
 
 	// Update the PipelineExecution status
 	if err := r.Status().Update(ctx, pe); err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return requeueAfter, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -175,5 +350,8 @@ func (r *PipelineExecutionReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.PipelineExecution{}).
 		Owns(&batchv1.Job{}).
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(r.queuedExecutions)).
+		Watches(&v1alpha1.PipelineExecution{}, handler.EnqueueRequestsFromMapFunc(r.queuedExecutions)).
+		Watches(&v1.Pod{}, handler.EnqueueRequestsFromMapFunc(r.queuedExecutions)).
 		Complete(r)
 }
@@ -0,0 +1,511 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+var _ = Describe("PipelineExecution controller", func() {
+	ctx := context.Background()
+	var reconciler *PipelineExecutionReconciler
+
+	BeforeEach(func() {
+		reconciler = &PipelineExecutionReconciler{
+			Client:                  k8sClient,
+			Scheme:                  scheme.Scheme,
+			RepositoryEmbedderImage: "repositoryembedder:test",
+		}
+	})
+
+	Context("When admitting an execution", func() {
+		It("should start it alongside running executions by default", func() {
+			pipeline := createPipeline(ctx, "", nil)
+			first := createExecution(ctx, pipeline, "a")
+			second := createExecution(ctx, pipeline, "b")
+
+			reconcileExecution(ctx, reconciler, first)
+			reconcileExecution(ctx, reconciler, second)
+
+			Expect(first.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+			Expect(getJob(ctx, second.Namespace, second.Name)).To(Succeed())
+		})
+
+		It("should skip it while an execution runs if the policy is Forbid", func() {
+			pipeline := createPipeline(ctx, v1alpha1.ConcurrencyPolicyForbid, nil)
+			first := createExecution(ctx, pipeline, "a")
+			second := createExecution(ctx, pipeline, "b")
+
+			reconcileExecution(ctx, reconciler, first)
+			Expect(first.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+
+			reconcileExecution(ctx, reconciler, second)
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateSkipped)))
+			Expect(admission(second)).To(HaveField("Reason", v1alpha1.AdmissionReasonForbidden))
+			Expect(second.Status.CompletionTime).NotTo(BeNil())
+			Expect(errors.IsNotFound(getJob(ctx, second.Namespace, second.Name))).To(BeTrue())
+
+			By("starting the next execution once the running execution finished")
+			updateJobStatus(ctx, first.Namespace, first.Name, func(status *batchv1.JobStatus) {
+				status.Succeeded = 1
+			})
+			reconcileExecution(ctx, reconciler, first)
+			Expect(first.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateSucceeded)))
+
+			third := createExecution(ctx, pipeline, "c")
+			reconcileExecution(ctx, reconciler, third)
+			Expect(third.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+		})
+
+		It("should count a started execution as running without its job", func() {
+			pipeline := createPipeline(ctx, v1alpha1.ConcurrencyPolicyForbid, nil)
+			first := createExecution(ctx, pipeline, "a")
+			second := createExecution(ctx, pipeline, "b")
+
+			reconcileExecution(ctx, reconciler, first)
+			// The job of a started execution can be missing from the cache of the manager.
+			job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: first.Name, Namespace: first.Namespace}}
+			Expect(k8sClient.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground))).To(Succeed())
+
+			reconcileExecution(ctx, reconciler, second)
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateSkipped)))
+		})
+
+		It("should delete the running executions if the policy is Replace", func() {
+			pipeline := createPipeline(ctx, v1alpha1.ConcurrencyPolicyReplace, nil)
+			first := createExecution(ctx, pipeline, "a")
+			second := createExecution(ctx, pipeline, "b")
+
+			reconcileExecution(ctx, reconciler, first)
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(first), job)).To(Succeed())
+			pod := createJobPod(ctx, job)
+
+			reconcileExecution(ctx, reconciler, second)
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(first), &v1alpha1.PipelineExecution{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			By("waiting for the pods of the replaced execution to terminate")
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStatePending)))
+			Expect(admission(second)).To(HaveField("Reason", v1alpha1.AdmissionReasonQueued))
+			Expect(errors.IsNotFound(getJob(ctx, second.Namespace, second.Name))).To(BeTrue())
+
+			By("starting it once the pods terminated")
+			Expect(k8sClient.Delete(ctx, pod, client.GracePeriodSeconds(0))).To(Succeed())
+			reconcileExecution(ctx, reconciler, second)
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+			Expect(getJob(ctx, second.Namespace, second.Name)).To(Succeed())
+		})
+
+		It("should queue it behind the running execution if the policy is Queue", func() {
+			pipeline := createPipeline(ctx, v1alpha1.ConcurrencyPolicyQueue, nil)
+			first := createExecution(ctx, pipeline, "a")
+			second := createExecution(ctx, pipeline, "b")
+			third := createExecution(ctx, pipeline, "c")
+
+			reconcileExecution(ctx, reconciler, first)
+			Expect(first.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+
+			reconcileExecution(ctx, reconciler, second)
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStatePending)))
+			Expect(admission(second)).To(HaveField("Reason", v1alpha1.AdmissionReasonQueued))
+
+			By("skipping the executions queued after it")
+			reconcileExecution(ctx, reconciler, third)
+			Expect(third.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateSkipped)))
+			Expect(admission(third)).To(HaveField("Reason", v1alpha1.AdmissionReasonDuplicate))
+
+			By("starting it once the running execution finished")
+			updateJobStatus(ctx, first.Namespace, first.Name, func(status *batchv1.JobStatus) {
+				status.Succeeded = 1
+			})
+			reconcileExecution(ctx, reconciler, first)
+			reconcileExecution(ctx, reconciler, second)
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+			Expect(admission(second)).To(HaveField("Reason", v1alpha1.AdmissionReasonStarted))
+			Expect(getJob(ctx, second.Namespace, second.Name)).To(Succeed())
+		})
+	})
+
+	Context("When the job of an attempt failed", func() {
+		It("should fail the execution without a retry policy", func() {
+			pipeline := createPipeline(ctx, "", nil)
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+			updateJobStatus(ctx, pe.Namespace, pe.Name, func(status *batchv1.JobStatus) {
+				status.Failed = 1
+			})
+			reconcileExecution(ctx, reconciler, pe)
+
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateFailed)))
+			Expect(pe.Status.Reason).To(Equal(v1alpha1.FailureReasonError))
+			Expect(pe.Status.CompletionTime).NotTo(BeNil())
+			Expect(pe.Status.NextAttemptTime).To(BeNil())
+			Expect(pe.Status.Attempts).To(HaveLen(1))
+			Expect(pe.Status.Attempts[0].State).To(Equal(v1alpha1.PipelineExecutionStateFailed))
+		})
+
+		It("should start the next attempt after the backoff of the retry policy", func() {
+			maxAttempts, backoffSeconds := int32(2), int32(1)
+			pipeline := createPipeline(ctx, "", &v1alpha1.RetryPolicy{MaxAttempts: &maxAttempts, BackoffSeconds: &backoffSeconds})
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+			updateJobStatus(ctx, pe.Namespace, pe.Name, func(status *batchv1.JobStatus) {
+				status.Failed = 1
+			})
+			result := reconcileExecution(ctx, reconciler, pe)
+
+			Expect(result.RequeueAfter).To(Equal(time.Second))
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+			Expect(pe.Status.NextAttemptTime).NotTo(BeNil())
+			Expect(pe.Status.Attempts).To(HaveLen(1))
+			Expect(pe.Status.Attempts[0].State).To(Equal(v1alpha1.PipelineExecutionStateFailed))
+			Expect(pe.Status.Attempts[0].Reason).To(Equal(v1alpha1.FailureReasonError))
+
+			By("starting the second attempt once the backoff passed")
+			Eventually(func(g Gomega) {
+				reconcileExecution(ctx, reconciler, pe)
+				g.Expect(pe.Status.Attempts).To(HaveLen(2))
+			}).WithTimeout(5 * time.Second).WithPolling(200 * time.Millisecond).Should(Succeed())
+			Expect(pe.Status.Attempts[1].JobName).To(Equal(pe.Name + "-2"))
+			Expect(pe.Status.Attempts[1].State).To(Equal(v1alpha1.PipelineExecutionStateActive))
+			Expect(pe.Status.NextAttemptTime).To(BeNil())
+			Expect(getJob(ctx, pe.Namespace, pe.Name+"-2")).To(Succeed())
+
+			By("failing the execution once the attempts are exhausted")
+			updateJobStatus(ctx, pe.Namespace, pe.Name+"-2", func(status *batchv1.JobStatus) {
+				status.Failed = 1
+			})
+			reconcileExecution(ctx, reconciler, pe)
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateFailed)))
+			Expect(pe.Status.NextAttemptTime).To(BeNil())
+		})
+		It("should fail the attempt of a job that failed without a failed pod", func() {
+			maxAttempts := int32(2)
+			pipeline := createPipeline(ctx, "", &v1alpha1.RetryPolicy{MaxAttempts: &maxAttempts})
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+			updateJobStatus(ctx, pe.Namespace, pe.Name, func(status *batchv1.JobStatus) {
+				status.Conditions = append(status.Conditions, batchv1.JobCondition{
+					Type:    batchv1.JobFailed,
+					Status:  v1.ConditionTrue,
+					Reason:  "DeadlineExceeded",
+					Message: "Job was active longer than specified deadline",
+				})
+			})
+			reconcileExecution(ctx, reconciler, pe)
+
+			// Attempts past their deadline are not retried by default.
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateFailed)))
+			Expect(pe.Status.Reason).To(Equal(v1alpha1.FailureReasonDeadlineExceeded))
+			Expect(pe.Status.Message).To(Equal("Job was active longer than specified deadline"))
+			Expect(pe.Status.Attempts).To(HaveLen(1))
+		})
+		It("should not start another attempt of a finished execution", func() {
+			maxAttempts := int32(3)
+			pipeline := createPipeline(ctx, "", &v1alpha1.RetryPolicy{MaxAttempts: &maxAttempts})
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+			updateJobStatus(ctx, pe.Namespace, pe.Name, func(status *batchv1.JobStatus) {
+				status.Failed = 1
+			})
+			reconcileExecution(ctx, reconciler, pe)
+			Expect(pe.Status.NextAttemptTime).NotTo(BeNil())
+
+			// The execution finished while its next attempt was pending.
+			failed := v1alpha1.PipelineExecutionStateFailed
+			past := metav1.NewTime(time.Now().Add(-time.Minute))
+			pe.Status.State = &failed
+			pe.Status.CompletionTime = &past
+			pe.Status.NextAttemptTime = &past
+			Expect(k8sClient.Status().Update(ctx, pe)).To(Succeed())
+
+			reconcileExecution(ctx, reconciler, pe)
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateFailed)))
+			Expect(pe.Status.Attempts).To(HaveLen(1))
+			Expect(errors.IsNotFound(getJob(ctx, pe.Namespace, pe.Name+"-2"))).To(BeTrue())
+		})
+	})
+
+	Context("When starting an attempt", func() {
+		It("should apply the job template of the pipeline", func() {
+			deadline := int64(3600)
+			pipeline := createPipeline(ctx, "", nil)
+			pipeline.Spec.JobTemplate = &v1alpha1.JobTemplate{
+				Annotations:           map[string]string{"sidecar.istio.io/inject": "false"},
+				Resources:             v1.ResourceRequirements{Limits: v1.ResourceList{v1.ResourceMemory: resource.MustParse("2Gi")}},
+				Env:                   []v1.EnvVar{{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}},
+				NodeSelector:          map[string]string{"pool": "embedders"},
+				PriorityClassName:     "batch",
+				ImagePullSecrets:      []v1.LocalObjectReference{{Name: "registry"}},
+				ActiveDeadlineSeconds: &deadline,
+			}
+			Expect(k8sClient.Update(ctx, pipeline)).To(Succeed())
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: pe.Namespace, Name: pe.Name}, job)).To(Succeed())
+			Expect(job.Spec.ActiveDeadlineSeconds).To(HaveValue(Equal(deadline)))
+			pod := job.Spec.Template
+			Expect(pod.Annotations).To(HaveKeyWithValue("sidecar.istio.io/inject", "false"))
+			Expect(pod.Spec.NodeSelector).To(HaveKeyWithValue("pool", "embedders"))
+			Expect(pod.Spec.PriorityClassName).To(Equal("batch"))
+			Expect(pod.Spec.ImagePullSecrets).To(ConsistOf(v1.LocalObjectReference{Name: "registry"}))
+			Expect(pod.Spec.ServiceAccountName).To(Equal("pipeline-worker"))
+
+			// The embedder keeps its command and arguments.
+			container := pod.Spec.Containers[0]
+			Expect(container.Command).To(Equal([]string{"./main"}))
+			Expect(container.Args).NotTo(BeEmpty())
+			Expect(container.Resources.Limits.Memory().String()).To(Equal("2Gi"))
+			Expect(container.Env).To(ContainElement(v1.EnvVar{Name: "HTTPS_PROXY", Value: "http://proxy:3128"}))
+		})
+	})
+
+	Context("When an execution is sharded", func() {
+		It("should record the indexed commits once all shards succeeded", func() {
+			pipeline := createPipeline(ctx, "", nil)
+			pipeline.Spec.RepositoryEmbeddings.Shards = 3
+			Expect(k8sClient.Update(ctx, pipeline)).To(Succeed())
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: pe.Namespace, Name: pe.Name}, job)).To(Succeed())
+			Expect(job.Spec.CompletionMode).To(HaveValue(Equal(batchv1.IndexedCompletion)))
+			Expect(job.Spec.Completions).To(HaveValue(Equal(int32(3))))
+			Expect(job.Spec.Parallelism).To(HaveValue(Equal(int32(3))))
+			Expect(job.Spec.Template.Spec.Containers[0].Args).To(ContainElements("--shard=$(JOB_COMPLETION_INDEX)", "--shards=3"))
+
+			By("waiting for every shard")
+			updateJobStatus(ctx, pe.Namespace, pe.Name, func(status *batchv1.JobStatus) {
+				status.Succeeded = 2
+			})
+			reconcileExecution(ctx, reconciler, pe)
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+			Expect(pe.Status.Attempts[0].FinalizeJobName).To(BeEmpty())
+
+			By("starting the finalize job once all shards succeeded")
+			updateJobStatus(ctx, pe.Namespace, pe.Name, func(status *batchv1.JobStatus) {
+				status.Succeeded = 3
+			})
+			reconcileExecution(ctx, reconciler, pe)
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+			Expect(pe.Status.Attempts[0].FinalizeJobName).To(Equal(pe.Name + "-finalize"))
+			finalize := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: pe.Namespace, Name: pe.Name + "-finalize"}, finalize)).To(Succeed())
+			Expect(finalize.Spec.CompletionMode).To(HaveValue(Equal(batchv1.NonIndexedCompletion)))
+			Expect(finalize.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--finalize"))
+
+			By("succeeding once the finalize job succeeded")
+			updateJobStatus(ctx, pe.Namespace, pe.Name+"-finalize", func(status *batchv1.JobStatus) {
+				status.Succeeded = 1
+			})
+			reconcileExecution(ctx, reconciler, pe)
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateSucceeded)))
+		})
+	})
+
+	Context("When an execution is cancelled", func() {
+		It("should delete the job of the running attempt", func() {
+			pipeline := createPipeline(ctx, "", nil)
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+			cancelExecution(ctx, pe)
+			reconcileExecution(ctx, reconciler, pe)
+
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateCancelled)))
+			Expect(pe.Status.CompletionTime).NotTo(BeNil())
+			Expect(pe.Status.Message).To(Equal("the execution was cancelled"))
+			Expect(pe.Status.Attempts).To(HaveLen(1))
+			Expect(pe.Status.Attempts[0].State).To(Equal(v1alpha1.PipelineExecutionStateCancelled))
+			Expect(errors.IsNotFound(getJob(ctx, pe.Namespace, pe.Name))).To(BeTrue())
+		})
+
+		It("should not start the next attempt of an execution waiting to retry", func() {
+			maxAttempts, backoffSeconds := int32(2), int32(0)
+			pipeline := createPipeline(ctx, "", &v1alpha1.RetryPolicy{MaxAttempts: &maxAttempts, BackoffSeconds: &backoffSeconds})
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+			updateJobStatus(ctx, pe.Namespace, pe.Name, func(status *batchv1.JobStatus) {
+				status.Failed = 1
+			})
+			reconcileExecution(ctx, reconciler, pe)
+			Expect(pe.Status.NextAttemptTime).NotTo(BeNil())
+
+			cancelExecution(ctx, pe)
+			reconcileExecution(ctx, reconciler, pe)
+			reconcileExecution(ctx, reconciler, pe)
+
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateCancelled)))
+			Expect(pe.Status.NextAttemptTime).To(BeNil())
+			Expect(pe.Status.Attempts).To(HaveLen(1))
+			Expect(pe.Status.Attempts[0].State).To(Equal(v1alpha1.PipelineExecutionStateFailed))
+			Expect(errors.IsNotFound(getJob(ctx, pe.Namespace, pe.Name+"-2"))).To(BeTrue())
+		})
+
+		It("should keep a finished execution", func() {
+			pipeline := createPipeline(ctx, "", nil)
+			pe := createExecution(ctx, pipeline, "a")
+
+			reconcileExecution(ctx, reconciler, pe)
+			updateJobStatus(ctx, pe.Namespace, pe.Name, func(status *batchv1.JobStatus) {
+				status.Succeeded = 1
+			})
+			reconcileExecution(ctx, reconciler, pe)
+			cancelExecution(ctx, pe)
+			reconcileExecution(ctx, reconciler, pe)
+
+			Expect(pe.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateSucceeded)))
+			Expect(pe.Status.Attempts[0].State).To(Equal(v1alpha1.PipelineExecutionStateSucceeded))
+			Expect(getJob(ctx, pe.Namespace, pe.Name)).To(Succeed())
+		})
+
+		It("should cancel a queued execution before it started", func() {
+			pipeline := createPipeline(ctx, v1alpha1.ConcurrencyPolicyQueue, nil)
+			first := createExecution(ctx, pipeline, "a")
+			second := createExecution(ctx, pipeline, "b")
+
+			reconcileExecution(ctx, reconciler, first)
+			reconcileExecution(ctx, reconciler, second)
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStatePending)))
+
+			cancelExecution(ctx, second)
+			reconcileExecution(ctx, reconciler, second)
+
+			Expect(second.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateCancelled)))
+			Expect(second.Status.Attempts).To(BeEmpty())
+			Expect(errors.IsNotFound(getJob(ctx, second.Namespace, second.Name))).To(BeTrue())
+			Expect(first.Status.State).To(HaveValue(Equal(v1alpha1.PipelineExecutionStateActive)))
+		})
+	})
+})
+
+// createPipeline creates an enabled pipeline with the concurrency and retry policy.
+func createPipeline(ctx context.Context, policy v1alpha1.ConcurrencyPolicy, retry *v1alpha1.RetryPolicy) *v1alpha1.Pipeline {
+	GinkgoHelper()
+	pipeline := &v1alpha1.Pipeline{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "pipeline-", Namespace: "default"},
+		Spec: v1alpha1.PipelineSpec{
+			Name:    "test",
+			Type:    v1alpha1.PipelineTypeRepositoryEmbeddings,
+			Enabled: true,
+			RepositoryEmbeddings: &v1alpha1.RepositoryEmbeddingsSpec{
+				Repository: v1.ObjectReference{Name: "repository"},
+				Model:      v1.ObjectReference{Name: "model"},
+				Storage:    v1.ObjectReference{Name: "storage"},
+			},
+			ConcurrencyPolicy: policy,
+			RetryPolicy:       retry,
+		},
+	}
+	Expect(k8sClient.Create(ctx, pipeline)).To(Succeed())
+	return pipeline
+}
+
+// createExecution creates an execution of the pipeline. Executions created in the same
+// second are ordered by their name, so by the suffix.
+func createExecution(ctx context.Context, pipeline *v1alpha1.Pipeline, suffix string) *v1alpha1.PipelineExecution {
+	GinkgoHelper()
+	pe := &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pipeline.Name + "-" + suffix,
+			Namespace: pipeline.Namespace,
+			Labels:    map[string]string{"pipelineId": pipeline.Name},
+		},
+		Spec: v1alpha1.PipelineExecutionSpec{
+			PipelineRef: v1.ObjectReference{Name: pipeline.Name},
+		},
+	}
+	Expect(k8sClient.Create(ctx, pe)).To(Succeed())
+	return pe
+}
+
+// reconcileExecution reconciles the execution and fetches it again.
+func reconcileExecution(ctx context.Context, r *PipelineExecutionReconciler, pe *v1alpha1.PipelineExecution) ctrl.Result {
+	GinkgoHelper()
+	result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(pe)})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(pe), pe)).To(Succeed())
+	return result
+}
+
+// cancelExecution requests the execution to be cancelled.
+func cancelExecution(ctx context.Context, pe *v1alpha1.PipelineExecution) {
+	GinkgoHelper()
+	pe.Spec.Cancelled = true
+	Expect(k8sClient.Update(ctx, pe)).To(Succeed())
+}
+
+func getJob(ctx context.Context, namespace, name string) error {
+	return k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &batchv1.Job{})
+}
+
+// createJobPod creates a running pod of the job, the test environment runs no job controller.
+func createJobPod(ctx context.Context, job *batchv1.Job) *v1.Pod {
+	GinkgoHelper()
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      job.Name + "-pod",
+			Namespace: job.Namespace,
+			Labels:    job.Spec.Template.Labels,
+		},
+		Spec: job.Spec.Template.Spec,
+	}
+	Expect(k8sClient.Create(ctx, pod)).To(Succeed())
+	pod.Status.Phase = v1.PodRunning
+	Expect(k8sClient.Status().Update(ctx, pod)).To(Succeed())
+	return pod
+}
+
+// updateJobStatus sets the status of the job, the test environment runs no job controller.
+func updateJobStatus(ctx context.Context, namespace, name string, update func(*batchv1.JobStatus)) {
+	GinkgoHelper()
+	job := &batchv1.Job{}
+	Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, job)).To(Succeed())
+	update(&job.Status)
+	Expect(k8sClient.Status().Update(ctx, job)).To(Succeed())
+}
+
+func admission(pe *v1alpha1.PipelineExecution) *metav1.Condition {
+	return meta.FindStatusCondition(pe.Status.Conditions, v1alpha1.PipelineExecutionConditionAdmitted)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+// maxFailureMessageLength is the maximum length of the failure message of an attempt,
+// the end of longer messages is kept since it holds the last log lines.
+const maxFailureMessageLength = 1024
+
+// lastAttempt returns the last attempt of the execution, or nil if it was not started.
+func lastAttempt(pe *v1alpha1.PipelineExecution) *v1alpha1.ExecutionAttempt {
+	if len(pe.Status.Attempts) == 0 {
+		return nil
+	}
+	return &pe.Status.Attempts[len(pe.Status.Attempts)-1]
+}
+
+// attemptJobName returns the name of the job of the attempt. The first attempt is
+// named after the execution.
+func attemptJobName(pe *v1alpha1.PipelineExecution, attempt int32) string {
+	if attempt == 1 {
+		return pe.Name
+	}
+	return fmt.Sprintf("%s-%d", pe.Name, attempt)
+}
+
+func completionTime(job *batchv1.Job) *metav1.Time {
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime
+	}
+	now := metav1.Now()
+	return &now
+}
+
+// jobSucceeded returns true if all pods of the job succeeded, one per shard for the
+// indexed job of a sharded attempt.
+func jobSucceeded(job *batchv1.Job) bool {
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	return job.Status.Succeeded >= completions
+}
+
+// jobFailed returns true if a pod of the job failed or the job failed without one, like
+// a job past its deadline whose pod never left Pending.
+func jobFailed(job *batchv1.Job) bool {
+	return job.Status.Failed > 0 || failedCondition(job) != nil
+}
+
+// failedCondition returns the JobFailed condition of the job if it is true.
+func failedCondition(job *batchv1.Job) *batchv1.JobCondition {
+	for i := range job.Status.Conditions {
+		if cond := &job.Status.Conditions[i]; cond.Type == batchv1.JobFailed && cond.Status == v1.ConditionTrue {
+			return cond
+		}
+	}
+	return nil
+}
+
+// attemptFailure returns the reason and message of the failed job of an attempt from
+// its pod, or from the job if the pod is gone.
+func (r *PipelineExecutionReconciler) attemptFailure(ctx context.Context, job *batchv1.Job) (v1alpha1.FailureReason, string, error) {
+	pods := &v1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(job.Namespace), client.MatchingLabels{"job-name": job.Name}); err != nil {
+		return "", "", fmt.Errorf("error listing pods: %w", err)
+	}
+
+	// The pod of a job past its deadline is deleted or failed without a reason of its own.
+	cond := failedCondition(job)
+	if cond != nil && cond.Reason == "DeadlineExceeded" {
+		return v1alpha1.FailureReasonDeadlineExceeded, failureMessage(cond.Message), nil
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != v1.PodFailed {
+			continue
+		}
+		if pod.Status.Reason == "Evicted" {
+			return v1alpha1.FailureReasonEvicted, failureMessage(pod.Status.Message), nil
+		}
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == v1.DisruptionTarget && cond.Status == v1.ConditionTrue {
+				return v1alpha1.FailureReasonEvicted, failureMessage(cond.Message), nil
+			}
+		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.State.Terminated == nil {
+				continue
+			}
+			if cs.State.Terminated.Reason == "OOMKilled" {
+				return v1alpha1.FailureReasonOOMKilled, failureMessage(cs.State.Terminated.Message), nil
+			}
+			return v1alpha1.FailureReasonError, failureMessage(cs.State.Terminated.Message), nil
+		}
+	}
+
+	if cond != nil {
+		if cond.Message == "" {
+			return v1alpha1.FailureReasonError, cond.Reason, nil
+		}
+		return v1alpha1.FailureReasonError, failureMessage(cond.Message), nil
+	}
+	return v1alpha1.FailureReasonError, "", nil
+}
+
+func failureMessage(message string) string {
+	message = strings.TrimSpace(message)
+	if len(message) > maxFailureMessageLength {
+		message = strings.ToValidUTF8(message[len(message)-maxFailureMessageLength:], "")
+	}
+	return message
+}
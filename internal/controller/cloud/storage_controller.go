@@ -289,18 +289,24 @@ func (r *StorageReconciler) ensurePasswordSecret(ctx context.Context, storage *v
 			if err != nil {
 				return err
 			}
-			passwordArg := fmt.Sprintf("--requirepass %s", password)
+			stringData := map[string]string{
+				"password": password,
+			}
+			switch storage.Spec.Type {
+			case v1alpha1.StorageTypeRedis:
+				stringData["redis-password"] = fmt.Sprintf("--requirepass %s", password)
+			case v1alpha1.StorageTypeElasticsearch:
+				// The password is set for the built-in superuser.
+				stringData["username"] = "elastic"
+			}
 
 			secret := &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      storage.Name,
 					Namespace: storage.Namespace,
 				},
-				StringData: map[string]string{
-					"password":       password,
-					"redis-password": passwordArg,
-				},
-				Type: corev1.SecretTypeOpaque,
+				StringData: stringData,
+				Type:       corev1.SecretTypeOpaque,
 			}
 			// Set Organization instance as the owner and controller of the secret.
 			if err := controllerutil.SetControllerReference(storage, secret, r.Scheme); err != nil {
@@ -496,6 +502,8 @@ func (r *StorageReconciler) createDeployment(ctx context.Context, storage *v1alp
 		if err := r.Status().Update(ctx, storage); err != nil {
 			return err
 		}
+	} else if storage.Spec.Type == v1alpha1.StorageTypeElasticsearch {
+		return r.createElasticsearchDeployment(ctx, storage)
 	} else {
 		return fmt.Errorf("unsupported storage type: %s", storage.Spec.Type)
 	}
@@ -503,6 +511,217 @@ func (r *StorageReconciler) createDeployment(ctx context.Context, storage *v1alp
 	return nil
 }
 
+// createElasticsearchDeployment deploys a single-node Elasticsearch cluster with
+// security enabled and plain http, since it is only reachable inside the cluster.
+func (r *StorageReconciler) createElasticsearchDeployment(ctx context.Context, storage *v1alpha1.Storage) error {
+	err := r.ensurePasswordSecret(ctx, storage)
+	if err != nil {
+		return err
+	}
+
+	// Define the Persistent Volume Claim name
+	pvcName := types.NamespacedName{Name: storage.Name, Namespace: storage.Namespace}
+
+	// Define the Persistent Volume Claim object
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcName.Name,
+			Namespace: pvcName.Namespace,
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			Resources: corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("1Gi"),
+				},
+			},
+		},
+	}
+	if err := controllerutil.SetControllerReference(storage, pvc, r.Scheme); err != nil {
+		return err
+	}
+
+	// Create the Persistent Volume Claim
+	if err := r.Create(ctx, pvc); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	// The JVM heap is half of the memory limit, the rest is left to the file system cache.
+	heap := storage.Spec.Deployment.Memory.Value() / 2 / (1024 * 1024)
+	if heap < 256 {
+		heap = 256
+	}
+
+	// Label the pods with the storage name so several clusters can run in one namespace.
+	labels := map[string]string{"app": "elasticsearch", "storage": storage.Name}
+
+	// Define the Kubernetes Deployment
+	deploy := &v1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      storage.Name,
+			Namespace: storage.Namespace,
+			Labels:    labels,
+		},
+	}
+
+	// Set the storage as the owner of the deployment.
+	if err := controllerutil.SetControllerReference(storage, deploy, r.Scheme); err != nil {
+		return err
+	}
+
+	// Apply the Deployment to the cluster
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, deploy, func() error {
+		deploy.Spec = v1.DeploymentSpec{
+			Replicas: pointer.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			// Two pods must never share the data volume.
+			Strategy: v1.DeploymentStrategy{
+				Type: v1.RecreateDeploymentStrategyType,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					SecurityContext: &corev1.PodSecurityContext{
+						FSGroup: pointer.Int64Ptr(1000),
+					},
+					Containers: []corev1.Container{
+						{
+							Name:  "elasticsearch",
+							Image: "docker.elastic.co/elasticsearch/elasticsearch:8.13.4",
+							Ports: []corev1.ContainerPort{
+								{
+									ContainerPort: 9200,
+								},
+							},
+							Env: []corev1.EnvVar{
+								{
+									Name:  "discovery.type",
+									Value: "single-node",
+								},
+								{
+									Name:  "xpack.security.enabled",
+									Value: "true",
+								},
+								{
+									Name:  "xpack.security.http.ssl.enabled",
+									Value: "false",
+								},
+								{
+									// Avoids raising vm.max_map_count on the node.
+									Name:  "node.store.allow_mmap",
+									Value: "false",
+								},
+								{
+									Name:  "ES_JAVA_OPTS",
+									Value: fmt.Sprintf("-Xms%dm -Xmx%dm", heap, heap),
+								},
+								{
+									Name: "ELASTIC_PASSWORD",
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{
+												Name: storage.Name,
+											},
+											Key: "password",
+										},
+									},
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									TCPSocket: &corev1.TCPSocketAction{
+										Port: intstr.FromInt(9200),
+									},
+								},
+								InitialDelaySeconds: 10,
+								PeriodSeconds:       10,
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "elasticsearch-data",
+									MountPath: "/usr/share/elasticsearch/data",
+								},
+							},
+							Resources: corev1.ResourceRequirements{
+								Limits: corev1.ResourceList{
+									"cpu":    storage.Spec.Deployment.CPU,
+									"memory": storage.Spec.Deployment.Memory,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "elasticsearch-data",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: pvcName.Name,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Define the Kubernetes Service for elasticsearch
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      storage.Name,
+			Namespace: storage.Namespace,
+		},
+	}
+
+	// Set the storage as the owner of the service.
+	if err := controllerutil.SetControllerReference(storage, svc, r.Scheme); err != nil {
+		return err
+	}
+
+	// Apply the Service to the cluster
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, svc, func() error {
+		svc.Spec = corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Protocol:   corev1.ProtocolTCP,
+					Port:       9200,
+					TargetPort: intstr.FromInt(9200),
+				},
+			},
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// Update the status of the storage.
+	state := v1alpha1.StorageStateDeploying
+	storage.Status.State = &state
+	// Add condition to the storage.
+	storage.Status.Conditions = append(storage.Status.Conditions, metav1.Condition{
+		Type:               string(v1alpha1.StorageStateDeploying),
+		Status:             metav1.ConditionTrue,
+		Reason:             "StorageDeploymentCreated",
+		Message:            "Storage deployment created successfully",
+		LastTransitionTime: metav1.Now(),
+	})
+	// Update the status of the storage.
+	return r.Status().Update(ctx, storage)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *StorageReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	// Create an EventHandler for watching PipelineExecution objects
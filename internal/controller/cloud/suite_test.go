@@ -18,6 +18,7 @@ package cloud
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"runtime"
 	"testing"
@@ -44,6 +45,11 @@ var k8sClient client.Client
 var testEnv *envtest.Environment
 
 func TestControllers(t *testing.T) {
+	// The specs need the binaries of the test environment, they are only skipped
+	// if asked for explicitly.
+	if os.Getenv("SKIP_ENVTEST") == "1" {
+		t.Skip("SKIP_ENVTEST is set")
+	}
 	RegisterFailHandler(Fail)
 
 	RunSpecs(t, "Controller Suite")
@@ -0,0 +1,40 @@
+package elasticsearchcache
+
+import (
+	"encoding/json"
+
+	"github.com/encoder-run/operator/pkg/elasticsearch"
+	"github.com/go-git/go-git/v5/config"
+)
+
+type ConfigStorage struct {
+	client          *elasticsearch.Client
+	namespacePrefix string
+}
+
+// SetConfig stores the configuration in Elasticsearch.
+func (s *ConfigStorage) SetConfig(cfg *config.Config) error {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+	return setBlob(s.client, elasticsearch.ConfigIndex, s.namespacePrefix, data)
+}
+
+// Config retrieves the configuration from Elasticsearch.
+func (s *ConfigStorage) Config() (*config.Config, error) {
+	data, err := getBlob(s.client, elasticsearch.ConfigIndex, s.namespacePrefix)
+	if err != nil {
+		return nil, err
+	}
+	// If not found return a new configuration.
+	if data == nil {
+		return config.NewConfig(), nil
+	}
+
+	var cfg config.Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
@@ -0,0 +1,40 @@
+package elasticsearchcache
+
+import (
+	"encoding/json"
+
+	"github.com/encoder-run/operator/pkg/elasticsearch"
+	"github.com/go-git/go-git/v5/plumbing/format/index"
+)
+
+type IndexStorage struct {
+	client          *elasticsearch.Client
+	namespacePrefix string
+}
+
+// SetIndex serializes and stores the index in Elasticsearch.
+func (s *IndexStorage) SetIndex(idx *index.Index) error {
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	return setBlob(s.client, elasticsearch.IndexIndex, s.namespacePrefix, data)
+}
+
+// Index retrieves and deserializes the index from Elasticsearch.
+func (s *IndexStorage) Index() (*index.Index, error) {
+	data, err := getBlob(s.client, elasticsearch.IndexIndex, s.namespacePrefix)
+	if err != nil {
+		return nil, err
+	}
+	// If not found return a new index.
+	if data == nil {
+		return &index.Index{Version: 2}, nil
+	}
+
+	var idx index.Index
+	if err := json.Unmarshal(data, &idx); err != nil {
+		return nil, err
+	}
+	return &idx, nil
+}
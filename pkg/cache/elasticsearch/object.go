@@ -0,0 +1,212 @@
+package elasticsearchcache
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/encoder-run/operator/pkg/elasticsearch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+type ObjectStorage struct {
+	client          *elasticsearch.Client
+	namespacePrefix string
+}
+
+func (o *ObjectStorage) NewEncodedObject() plumbing.EncodedObject {
+	return &plumbing.MemoryObject{}
+}
+
+// SetEncodedObject stores an encoded object in Elasticsearch.
+func (s *ObjectStorage) SetEncodedObject(obj plumbing.EncodedObject) (plumbing.Hash, error) {
+	r, err := obj.Reader()
+	if err != nil {
+		return obj.Hash(), err
+	}
+
+	bytes, err := io.ReadAll(r)
+	if err != nil {
+		return obj.Hash(), err
+	}
+
+	object := &elasticsearch.Object{
+		URL:  s.namespacePrefix,
+		Hash: obj.Hash().String(),
+		Type: obj.Type().String(),
+		Size: int64(len(bytes)),
+		Blob: bytes,
+	}
+	return obj.Hash(), s.client.Index(elasticsearch.ObjectsIndex, elasticsearch.DocumentID(s.namespacePrefix, object.Hash), object)
+}
+
+// EncodedObject retrieves an encoded object from Elasticsearch by its hash.
+func (s *ObjectStorage) EncodedObject(t plumbing.ObjectType, h plumbing.Hash) (plumbing.EncodedObject, error) {
+	object, err := s.object(h)
+	if err != nil {
+		return nil, err
+	}
+
+	oType, err := plumbing.ParseObjectType(object.Type)
+	if err != nil {
+		return nil, err
+	}
+	if t != plumbing.AnyObject && t != oType {
+		return nil, plumbing.ErrObjectNotFound
+	}
+
+	return toMemoryObject(oType, object)
+}
+
+// HasEncodedObject checks if an encoded object exists in Elasticsearch by its hash.
+func (s *ObjectStorage) HasEncodedObject(hash plumbing.Hash) error {
+	exists, err := s.client.Exists(elasticsearch.ObjectsIndex, elasticsearch.DocumentID(s.namespacePrefix, hash.String()))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return plumbing.ErrObjectNotFound
+	}
+	return nil
+}
+
+// EncodedObjectSize retrieves the size of an encoded object from Elasticsearch by its hash.
+func (s *ObjectStorage) EncodedObjectSize(hash plumbing.Hash) (int64, error) {
+	object, err := s.object(hash)
+	if err != nil {
+		return 0, err
+	}
+	return object.Size, nil
+}
+
+func (s *ObjectStorage) object(h plumbing.Hash) (*elasticsearch.Object, error) {
+	doc, err := s.client.Get(elasticsearch.ObjectsIndex, elasticsearch.DocumentID(s.namespacePrefix, h.String()))
+	if err != nil {
+		if errors.Is(err, elasticsearch.ErrNotFound) {
+			return nil, plumbing.ErrObjectNotFound
+		}
+		return nil, err
+	}
+
+	var object elasticsearch.Object
+	if err := json.Unmarshal(doc.Source, &object); err != nil {
+		return nil, err
+	}
+	return &object, nil
+}
+
+func toMemoryObject(t plumbing.ObjectType, object *elasticsearch.Object) (*plumbing.MemoryObject, error) {
+	o := &plumbing.MemoryObject{}
+	o.SetType(t)
+	o.SetSize(object.Size)
+	if _, err := o.Write(object.Blob); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+type EncodedObjectIter struct {
+	client          *elasticsearch.Client
+	namespacePrefix string
+	objectType      plumbing.ObjectType
+	searchAfter     []interface{}
+	limit           int
+	objects         []*plumbing.MemoryObject
+	moreData        bool
+}
+
+// IterEncodedObjects returns an iterator for encoded objects stored in Elasticsearch.
+func (s *ObjectStorage) IterEncodedObjects(t plumbing.ObjectType) (storer.EncodedObjectIter, error) {
+	return &EncodedObjectIter{
+		client:          s.client,
+		namespacePrefix: s.namespacePrefix,
+		objectType:      t,
+		limit:           100, // Define your batch size
+		moreData:        true,
+	}, nil
+}
+
+// fetchNextBatch fetches the next batch of encoded objects sorted by hash.
+func (iter *EncodedObjectIter) fetchNextBatch() error {
+	filter := []interface{}{
+		map[string]interface{}{"term": map[string]interface{}{"url": iter.namespacePrefix}},
+	}
+	if iter.objectType != plumbing.AnyObject {
+		filter = append(filter, map[string]interface{}{"term": map[string]interface{}{"type": iter.objectType.String()}})
+	}
+	body := map[string]interface{}{
+		"size":  iter.limit,
+		"query": map[string]interface{}{"bool": map[string]interface{}{"filter": filter}},
+		"sort":  []interface{}{map[string]interface{}{"hash": "asc"}},
+	}
+	if iter.searchAfter != nil {
+		body["search_after"] = iter.searchAfter
+	}
+
+	result, err := iter.client.Search(elasticsearch.ObjectsIndex, body)
+	if err != nil {
+		iter.moreData = false
+		return err
+	}
+	hits := result.Hits.Hits
+	iter.moreData = len(hits) == iter.limit
+	if len(hits) > 0 {
+		iter.searchAfter = hits[len(hits)-1].Sort
+	}
+
+	iter.objects = make([]*plumbing.MemoryObject, 0, len(hits))
+	for _, hit := range hits {
+		var object elasticsearch.Object
+		if err := json.Unmarshal(hit.Source, &object); err != nil {
+			return err
+		}
+		oType, err := plumbing.ParseObjectType(object.Type)
+		if err != nil {
+			return err
+		}
+		o, err := toMemoryObject(oType, &object)
+		if err != nil {
+			return err
+		}
+		iter.objects = append(iter.objects, o)
+	}
+	return nil
+}
+
+// Next retrieves the next encoded object, advancing the iterator.
+func (iter *EncodedObjectIter) Next() (plumbing.EncodedObject, error) {
+	if len(iter.objects) == 0 && iter.moreData {
+		if err := iter.fetchNextBatch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(iter.objects) > 0 {
+		obj := iter.objects[0]
+		iter.objects = iter.objects[1:]
+		return obj, nil
+	}
+	return nil, io.EOF
+}
+
+// ForEach implements the required method to iterate over each object.
+func (iter *EncodedObjectIter) ForEach(cb func(obj plumbing.EncodedObject) error) error {
+	for {
+		obj, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := cb(obj); err != nil {
+			if err == storer.ErrStop {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// Close is a placeholder to satisfy the EncodedObjectIter interface.
+func (iter *EncodedObjectIter) Close() {}
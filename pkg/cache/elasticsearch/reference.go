@@ -0,0 +1,216 @@
+package elasticsearchcache
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/encoder-run/operator/pkg/elasticsearch"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/storage"
+)
+
+type ReferenceStorage struct {
+	client          *elasticsearch.Client
+	namespacePrefix string
+}
+
+// SetReference stores a reference in Elasticsearch.
+func (r *ReferenceStorage) SetReference(ref *plumbing.Reference) error {
+	return r.client.Index(elasticsearch.ReferencesIndex, r.id(ref.Name()), r.toDocument(ref))
+}
+
+// Reference retrieves a reference from Elasticsearch by its name.
+func (r *ReferenceStorage) Reference(name plumbing.ReferenceName) (*plumbing.Reference, error) {
+	doc, err := r.client.Get(elasticsearch.ReferencesIndex, r.id(name))
+	if err != nil {
+		if errors.Is(err, elasticsearch.ErrNotFound) {
+			return nil, plumbing.ErrReferenceNotFound
+		}
+		return nil, err
+	}
+	return toReference(doc.Source)
+}
+
+// CheckAndSetReference sets the new reference only if the stored reference still
+// points to the hash of the old one. Concurrent updates are detected with the
+// sequence number of the stored document.
+func (r *ReferenceStorage) CheckAndSetReference(new, old *plumbing.Reference) error {
+	if new == nil {
+		return errors.New("new reference cannot be nil")
+	}
+	if old == nil {
+		return r.SetReference(new)
+	}
+
+	doc, err := r.client.Get(elasticsearch.ReferencesIndex, r.id(new.Name()))
+	if err != nil {
+		if errors.Is(err, elasticsearch.ErrNotFound) {
+			return plumbing.ErrReferenceNotFound
+		}
+		return err
+	}
+	current, err := toReference(doc.Source)
+	if err != nil {
+		return err
+	}
+	if current.Hash() != old.Hash() {
+		return storage.ErrReferenceHasChanged
+	}
+
+	if err := r.client.IndexIfMatch(elasticsearch.ReferencesIndex, r.id(new.Name()), r.toDocument(new), doc.SeqNo, doc.PrimaryTerm); err != nil {
+		if errors.Is(err, elasticsearch.ErrConflict) {
+			return storage.ErrReferenceHasChanged
+		}
+		return err
+	}
+	return nil
+}
+
+// RemoveReference deletes a reference from Elasticsearch.
+func (r *ReferenceStorage) RemoveReference(name plumbing.ReferenceName) error {
+	if err := r.client.Delete(elasticsearch.ReferencesIndex, r.id(name)); err != nil && !errors.Is(err, elasticsearch.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// PackRefs is a no-op since references are not stored as files.
+func (r *ReferenceStorage) PackRefs() error {
+	return nil
+}
+
+// CountLooseRefs returns the number of references of the repository.
+func (r *ReferenceStorage) CountLooseRefs() (int, error) {
+	iter, err := r.IterReferences()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	err = iter.ForEach(func(*plumbing.Reference) error {
+		count++
+		return nil
+	})
+	return count, err
+}
+
+// IterReferences returns an iterator for references stored in Elasticsearch.
+func (r *ReferenceStorage) IterReferences() (storer.ReferenceIter, error) {
+	return &ReferenceIter{
+		client:          r.client,
+		namespacePrefix: r.namespacePrefix,
+		limit:           100, // Define your batch size
+		moreData:        true,
+	}, nil
+}
+
+func (r *ReferenceStorage) id(name plumbing.ReferenceName) string {
+	return elasticsearch.DocumentID(r.namespacePrefix, name.String())
+}
+
+func (r *ReferenceStorage) toDocument(ref *plumbing.Reference) *elasticsearch.Reference {
+	return &elasticsearch.Reference{
+		URL:    r.namespacePrefix,
+		Name:   ref.Name().String(),
+		Type:   ref.Type().String(),
+		Target: ref.Target().String(),
+		Hash:   ref.Hash().String(),
+	}
+}
+
+func toReference(source json.RawMessage) (*plumbing.Reference, error) {
+	var reference elasticsearch.Reference
+	if err := json.Unmarshal(source, &reference); err != nil {
+		return nil, err
+	}
+
+	name := plumbing.ReferenceName(reference.Name)
+	switch reference.Type {
+	case plumbing.HashReference.String():
+		return plumbing.NewHashReference(name, plumbing.NewHash(reference.Hash)), nil
+	case plumbing.SymbolicReference.String():
+		return plumbing.NewSymbolicReference(name, plumbing.ReferenceName(reference.Target)), nil
+	default:
+		return nil, errors.New("unknown reference type")
+	}
+}
+
+type ReferenceIter struct {
+	client          *elasticsearch.Client
+	namespacePrefix string
+	searchAfter     []interface{}
+	limit           int
+	refs            []*plumbing.Reference
+	moreData        bool
+}
+
+// fetchNextBatch fetches the next batch of references sorted by name.
+func (iter *ReferenceIter) fetchNextBatch() error {
+	body := map[string]interface{}{
+		"size":  iter.limit,
+		"query": map[string]interface{}{"term": map[string]interface{}{"url": iter.namespacePrefix}},
+		"sort":  []interface{}{map[string]interface{}{"name": "asc"}},
+	}
+	if iter.searchAfter != nil {
+		body["search_after"] = iter.searchAfter
+	}
+
+	result, err := iter.client.Search(elasticsearch.ReferencesIndex, body)
+	if err != nil {
+		iter.moreData = false
+		return err
+	}
+	hits := result.Hits.Hits
+	iter.moreData = len(hits) == iter.limit
+	if len(hits) > 0 {
+		iter.searchAfter = hits[len(hits)-1].Sort
+	}
+
+	iter.refs = make([]*plumbing.Reference, 0, len(hits))
+	for _, hit := range hits {
+		ref, err := toReference(hit.Source)
+		if err != nil {
+			return err
+		}
+		iter.refs = append(iter.refs, ref)
+	}
+	return nil
+}
+
+// Next retrieves the next reference, advancing the iterator.
+func (iter *ReferenceIter) Next() (*plumbing.Reference, error) {
+	if len(iter.refs) == 0 && iter.moreData {
+		if err := iter.fetchNextBatch(); err != nil {
+			return nil, err
+		}
+	}
+	if len(iter.refs) > 0 {
+		ref := iter.refs[0]
+		iter.refs = iter.refs[1:]
+		return ref, nil
+	}
+	return nil, io.EOF
+}
+
+// ForEach implements the required method to iterate over each reference.
+func (iter *ReferenceIter) ForEach(cb func(obj *plumbing.Reference) error) error {
+	for {
+		ref, err := iter.Next()
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+		if err := cb(ref); err != nil {
+			if err == storer.ErrStop {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// Close is a placeholder to satisfy the ReferenceIter interface.
+func (iter *ReferenceIter) Close() {}
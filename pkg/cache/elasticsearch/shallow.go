@@ -0,0 +1,51 @@
+package elasticsearchcache
+
+import (
+	"encoding/json"
+
+	"github.com/encoder-run/operator/pkg/elasticsearch"
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+type ShallowStorage struct {
+	client          *elasticsearch.Client
+	namespacePrefix string
+}
+
+// SetShallow stores a list of shallow commit hashes in Elasticsearch.
+func (s *ShallowStorage) SetShallow(commits []plumbing.Hash) error {
+	var hashes []string
+	for _, hash := range commits {
+		hashes = append(hashes, hash.String())
+	}
+
+	data, err := json.Marshal(hashes)
+	if err != nil {
+		return err
+	}
+	return setBlob(s.client, elasticsearch.ShallowIndex, s.namespacePrefix, data)
+}
+
+// Shallow retrieves the list of shallow commit hashes from Elasticsearch.
+func (s *ShallowStorage) Shallow() ([]plumbing.Hash, error) {
+	data, err := getBlob(s.client, elasticsearch.ShallowIndex, s.namespacePrefix)
+	if err != nil {
+		return nil, err
+	}
+	// No entry found, return an empty list of hashes
+	if data == nil {
+		return []plumbing.Hash{}, nil
+	}
+
+	var hashes []string
+	if err := json.Unmarshal(data, &hashes); err != nil {
+		return nil, err
+	}
+
+	// Convert the strings to plumbing.Hash
+	commits := make([]plumbing.Hash, 0, len(hashes))
+	for _, h := range hashes {
+		commits = append(commits, plumbing.NewHash(h))
+	}
+	return commits, nil
+}
@@ -0,0 +1,101 @@
+// Package elasticsearchcache is a storage backend based on Elasticsearch.
+package elasticsearchcache
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/encoder-run/operator/pkg/elasticsearch"
+	"github.com/go-git/go-git/v5/storage"
+)
+
+var (
+	ErrUnsupportedObjectType = fmt.Errorf("unsupported object type")
+)
+
+// Storage implements git.Storer interface with Elasticsearch as backend. All
+// repositories share the same indices and are told apart by their url.
+type Storage struct {
+	client          *elasticsearch.Client
+	namespacePrefix string
+
+	ObjectStorage
+	ReferenceStorage
+	ConfigStorage
+	ShallowStorage
+	IndexStorage
+}
+
+// CreateIndices creates the indices used by the storage if they don't exist.
+func CreateIndices(client *elasticsearch.Client) error {
+	for index, body := range elasticsearch.GitIndices() {
+		if err := client.CreateIndex(index, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewStorage returns a new Elasticsearch-based storage.
+func NewStorage(client *elasticsearch.Client, ns string) *Storage {
+	return &Storage{
+		client:          client,
+		namespacePrefix: ns,
+		ObjectStorage: ObjectStorage{
+			client:          client,
+			namespacePrefix: ns,
+		},
+		ReferenceStorage: ReferenceStorage{
+			client:          client,
+			namespacePrefix: ns,
+		},
+		ConfigStorage: ConfigStorage{
+			client:          client,
+			namespacePrefix: ns,
+		},
+		ShallowStorage: ShallowStorage{
+			client:          client,
+			namespacePrefix: ns,
+		},
+		IndexStorage: IndexStorage{
+			client:          client,
+			namespacePrefix: ns,
+		},
+	}
+}
+
+var errNotSupported = fmt.Errorf("not supported")
+
+// Module implements storage.Storer.
+func (s *Storage) Module(name string) (storage.Storer, error) {
+	return nil, errNotSupported
+}
+
+// AddAlternate implements storage.Storer.
+func (s *Storage) AddAlternate(remote string) error {
+	return errNotSupported
+}
+
+// setBlob stores the serialized value as the only document of the repository in the index.
+func setBlob(client *elasticsearch.Client, index, ns string, data []byte) error {
+	return client.Index(index, ns, &elasticsearch.Blob{URL: ns, Blob: data})
+}
+
+// getBlob retrieves the serialized value of the repository from the index. It
+// returns nil if the repository has no document in the index.
+func getBlob(client *elasticsearch.Client, index, ns string) ([]byte, error) {
+	doc, err := client.Get(index, ns)
+	if err != nil {
+		if errors.Is(err, elasticsearch.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var blob elasticsearch.Blob
+	if err := json.Unmarshal(doc.Source, &blob); err != nil {
+		return nil, err
+	}
+	return blob.Blob, nil
+}
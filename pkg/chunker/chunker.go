@@ -0,0 +1,175 @@
+package chunker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Strategy defines how files are split into chunks.
+type Strategy string
+
+const (
+	// StrategyTokens splits files into fixed windows of tokens that overlap.
+	StrategyTokens Strategy = "TOKENS"
+	// StrategyLines splits files into fixed windows of lines that overlap.
+	StrategyLines Strategy = "LINES"
+	// StrategyLanguage splits files on the top level declarations of their language,
+	// e.g. functions and classes, and falls back to windows of tokens.
+	StrategyLanguage Strategy = "LANGUAGE"
+	// StrategySyntax splits files on the top level declarations of their syntax tree
+	// and records the names of the declarations. Builds without cgo fall back to the
+	// LANGUAGE strategy.
+	StrategySyntax Strategy = "SYNTAX"
+)
+
+const (
+	// DefaultTokens is the default number of tokens of a chunk.
+	DefaultTokens = 256
+	// DefaultTokenOverlap is the default number of tokens shared by consecutive chunks.
+	DefaultTokenOverlap = 32
+	// DefaultLines is the default number of lines of a chunk.
+	DefaultLines = 60
+	// DefaultLineOverlap is the default number of lines shared by consecutive chunks.
+	DefaultLineOverlap = 10
+)
+
+// Chunk is a [StartIndex, EndIndex) range of bytes of a file.
+type Chunk struct {
+	ID         int
+	StartIndex int
+	EndIndex   int
+	// Symbol is the name of the declaration of the chunk if known, e.g. a function.
+	Symbol string
+}
+
+// Chunker splits the content of files into chunks.
+type Chunker interface {
+	// Chunk splits the content of a file written in the language, e.g. "go", into
+	// chunks. The language is empty if it is unknown.
+	Chunk(content, language string) []Chunk
+}
+
+// New creates the chunker of the strategy. A size or overlap of 0 uses the default of
+// the strategy.
+func New(strategy Strategy, size, overlap int) (Chunker, error) {
+	if size < 0 || overlap < 0 {
+		return nil, fmt.Errorf("chunk size and overlap cannot be negative")
+	}
+	switch strategy {
+	case StrategyTokens, StrategyLanguage, StrategySyntax:
+		if size == 0 {
+			size = DefaultTokens
+		}
+		if overlap == 0 {
+			overlap = DefaultTokenOverlap
+		}
+	case StrategyLines:
+		if size == 0 {
+			size = DefaultLines
+		}
+		if overlap == 0 {
+			overlap = DefaultLineOverlap
+		}
+	default:
+		return nil, fmt.Errorf("unsupported chunking strategy: %s", strategy)
+	}
+	if overlap >= size {
+		return nil, fmt.Errorf("chunk overlap %d must be smaller than the chunk size %d", overlap, size)
+	}
+
+	switch strategy {
+	case StrategyTokens:
+		return &tokenChunker{size: size, overlap: overlap}, nil
+	case StrategyLines:
+		return &lineChunker{size: size, overlap: overlap}, nil
+	case StrategySyntax:
+		return newSyntaxChunker(tokenChunker{size: size, overlap: overlap}), nil
+	default:
+		return &languageChunker{tokens: tokenChunker{size: size, overlap: overlap}}, nil
+	}
+}
+
+// Default returns the chunker used when a pipeline doesn't configure one and the
+// model doesn't chunk files itself.
+func Default() Chunker {
+	return &tokenChunker{size: DefaultTokens, overlap: DefaultTokenOverlap}
+}
+
+// tokenPattern approximates the tokens of code models: words and single symbols.
+var tokenPattern = regexp.MustCompile(`\w+|[^\w\s]`)
+
+type tokenChunker struct {
+	size    int
+	overlap int
+}
+
+func (c *tokenChunker) Chunk(content, language string) []Chunk {
+	return c.chunkRange(content, 0, len(content), nil)
+}
+
+// chunkRange appends the windows of tokens of content[start:end] to chunks.
+func (c *tokenChunker) chunkRange(content string, start, end int, chunks []Chunk) []Chunk {
+	tokens := tokenPattern.FindAllStringIndex(content[start:end], -1)
+	for i := 0; i < len(tokens); i += c.size - c.overlap {
+		last := i + c.size
+		if last > len(tokens) {
+			last = len(tokens)
+		}
+		chunks = append(chunks, Chunk{
+			ID:         len(chunks),
+			StartIndex: start + tokens[i][0],
+			EndIndex:   start + tokens[last-1][1],
+		})
+		if last == len(tokens) {
+			break
+		}
+	}
+	return chunks
+}
+
+// countTokens returns the number of tokens of the content.
+func countTokens(content string) int {
+	return len(tokenPattern.FindAllStringIndex(content, -1))
+}
+
+type lineChunker struct {
+	size    int
+	overlap int
+}
+
+func (c *lineChunker) Chunk(content, language string) []Chunk {
+	lines := lineStarts(content)
+	chunks := make([]Chunk, 0)
+	for i := 0; i < len(lines); i += c.size - c.overlap {
+		last := i + c.size
+		if last >= len(lines) {
+			last = len(lines)
+		}
+		end := len(content)
+		if last < len(lines) {
+			end = lines[last]
+		}
+		if strings.TrimSpace(content[lines[i]:end]) != "" {
+			chunks = append(chunks, Chunk{ID: len(chunks), StartIndex: lines[i], EndIndex: end})
+		}
+		if last == len(lines) {
+			break
+		}
+	}
+	return chunks
+}
+
+// lineStarts returns the index of the first byte of every line of the content.
+func lineStarts(content string) []int {
+	if content == "" {
+		return nil
+	}
+	starts := []int{0}
+	for i := 0; i < len(content)-1; i++ {
+		if content[i] == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return starts
+}
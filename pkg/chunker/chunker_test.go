@@ -0,0 +1,162 @@
+package chunker
+
+import (
+	"reflect"
+	"testing"
+)
+
+// texts returns the content of the chunks, checking they are numbered in order and
+// their ranges are valid byte offsets of the content.
+func texts(t *testing.T, content string, chunks []Chunk) []string {
+	t.Helper()
+	texts := make([]string, 0, len(chunks))
+	for i, chunk := range chunks {
+		if chunk.ID != i {
+			t.Errorf("chunk %d has ID %d", i, chunk.ID)
+		}
+		if chunk.StartIndex < 0 || chunk.StartIndex >= chunk.EndIndex || chunk.EndIndex > len(content) {
+			t.Fatalf("chunk %d has invalid range [%d, %d) of %d bytes", i, chunk.StartIndex, chunk.EndIndex, len(content))
+		}
+		texts = append(texts, content[chunk.StartIndex:chunk.EndIndex])
+	}
+	return texts
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy Strategy
+		size     int
+		overlap  int
+		want     Chunker
+		wantErr  bool
+	}{
+		{name: "tokens with defaults", strategy: StrategyTokens, want: &tokenChunker{size: DefaultTokens, overlap: DefaultTokenOverlap}},
+		{name: "tokens", strategy: StrategyTokens, size: 100, overlap: 10, want: &tokenChunker{size: 100, overlap: 10}},
+		{name: "lines with defaults", strategy: StrategyLines, want: &lineChunker{size: DefaultLines, overlap: DefaultLineOverlap}},
+		{name: "lines", strategy: StrategyLines, size: 20, overlap: 5, want: &lineChunker{size: 20, overlap: 5}},
+		{name: "language", strategy: StrategyLanguage, size: 128, overlap: 16, want: &languageChunker{tokens: tokenChunker{size: 128, overlap: 16}}},
+		{name: "default overlap must be smaller than the size", strategy: StrategyTokens, size: 16, wantErr: true},
+		{name: "overlap equal to the size", strategy: StrategyLines, size: 10, overlap: 10, wantErr: true},
+		{name: "negative size", strategy: StrategyTokens, size: -1, wantErr: true},
+		{name: "negative overlap", strategy: StrategyTokens, size: 10, overlap: -1, wantErr: true},
+		{name: "unknown strategy", strategy: "WORDS", wantErr: true},
+		{name: "empty strategy", strategy: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := New(tt.strategy, tt.size, tt.overlap)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("New() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenChunker(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		overlap int
+		content string
+		want    []string
+	}{
+		{name: "empty", size: 4, overlap: 1, content: "", want: []string{}},
+		{name: "whitespace only", size: 4, overlap: 1, content: " \n\t", want: []string{}},
+		{name: "single window", size: 4, overlap: 1, content: "a b c", want: []string{"a b c"}},
+		{name: "windows overlap", size: 4, overlap: 1, content: "a b c d e f g", want: []string{"a b c d", "d e f g"}},
+		{name: "last window is shorter", size: 4, overlap: 1, content: "a b c d e f g h", want: []string{"a b c d", "d e f g", "g h"}},
+		{name: "no overlap", size: 2, overlap: 0, content: "a b c d e", want: []string{"a b", "c d", "e"}},
+		{name: "symbols are tokens", size: 4, overlap: 2, content: "foo(bar, baz)", want: []string{"foo(bar,", "bar, baz)"}},
+		{name: "leading and trailing whitespace is trimmed", size: 3, overlap: 0, content: "\n  x := 1\n\n", want: []string{"x :=", "1"}},
+		{name: "multi-byte characters", size: 3, overlap: 1, content: "héllo wörld", want: []string{"héllo", "llo wö", "örld"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &tokenChunker{size: tt.size, overlap: tt.overlap}
+			if got := texts(t, tt.content, c.Chunk(tt.content, "")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunk() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineChunker(t *testing.T) {
+	tests := []struct {
+		name    string
+		size    int
+		overlap int
+		content string
+		want    []string
+	}{
+		{name: "empty", size: 2, overlap: 1, content: "", want: []string{}},
+		{name: "single window", size: 3, overlap: 1, content: "a\nb\n", want: []string{"a\nb\n"}},
+		{name: "windows overlap", size: 2, overlap: 1, content: "a\nb\nc\n", want: []string{"a\nb\n", "b\nc\n"}},
+		{name: "no overlap", size: 2, overlap: 0, content: "a\nb\nc\nd\ne", want: []string{"a\nb\n", "c\nd\n", "e"}},
+		{name: "blank windows are skipped", size: 2, overlap: 0, content: "a\n\n\n\nb", want: []string{"a\n\n", "b"}},
+		{name: "carriage returns stay in the lines", size: 1, overlap: 0, content: "a\r\nb\r\n", want: []string{"a\r\n", "b\r\n"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &lineChunker{size: tt.size, overlap: tt.overlap}
+			if got := texts(t, tt.content, c.Chunk(tt.content, "")); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunk() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguageChunker(t *testing.T) {
+	goFile := "package main\n\n// A does a.\nfunc A() {}\n\nfunc B() {}\n"
+	pythonFile := "import os\n\n@cached\ndef a():\n    return 1\n\nclass B:\n    pass\n"
+
+	tests := []struct {
+		name     string
+		size     int
+		overlap  int
+		language string
+		content  string
+		want     []string
+	}{
+		{
+			name: "file that fits", size: 64, overlap: 0, language: "go", content: goFile,
+			want: []string{goFile},
+		},
+		{
+			name: "declarations with their comments", size: 12, overlap: 0, language: "go", content: goFile,
+			want: []string{"package main\n\n", "// A does a.\nfunc A() {}\n\n", "func B() {}\n"},
+		},
+		{
+			name: "declarations merged while they fit", size: 14, overlap: 0, language: "go", content: goFile,
+			want: []string{"package main\n\n// A does a.\nfunc A() {}\n\n", "func B() {}\n"},
+		},
+		{
+			name: "decorators are attached", size: 9, overlap: 0, language: "python", content: pythonFile,
+			want: []string{"import os\n\n", "@cached\ndef a():\n    return 1\n\n", "class B:\n    pass\n"},
+		},
+		{
+			name: "declaration split into windows of tokens", size: 4, overlap: 1, language: "go", content: "func A() { return }\n",
+			want: []string{"func A()", ") { return }"},
+		},
+		{
+			name: "unknown language is split into windows of tokens", size: 4, overlap: 1, language: "cobol", content: "a b c d e f g",
+			want: []string{"a b c d", "d e f g"},
+		},
+		{
+			name: "tsx uses the typescript declarations", size: 12, overlap: 0, language: "tsx",
+			content: "import React from 'react'\n\nexport function App() {\n  return <div />\n}\n",
+			want:    []string{"import React from 'react'\n\n", "export function App() {\n  return <div />\n}\n"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &languageChunker{tokens: tokenChunker{size: tt.size, overlap: tt.overlap}}
+			if got := texts(t, tt.content, c.Chunk(tt.content, tt.language)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunk() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package chunker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// typescriptDeclarations match the declarations of TypeScript files, with or without JSX.
+var typescriptDeclarations = regexp.MustCompile(`^(export\s+)?(default\s+)?(declare\s+)?(abstract\s+)?(async\s+)?(function|class|interface|type|enum|namespace|const|let|var)\b`)
+
+// declarationPatterns match the first line of the top level declarations of a language.
+// The languages are the values of the supported languages of the repository embedder.
+var declarationPatterns = map[string]*regexp.Regexp{
+	"go":            regexp.MustCompile(`^(func|type|var|const)\b`),
+	"python":        regexp.MustCompile(`^(async\s+def|def|class)\s`),
+	"javascript":    regexp.MustCompile(`^(export\s+)?(default\s+)?(async\s+)?(function|class|const|let|var)\b`),
+	"typescript":    typescriptDeclarations,
+	"tsx":           typescriptDeclarations,
+	"ruby":          regexp.MustCompile(`^\s{0,2}(def|class|module)\s`),
+	"java":          regexp.MustCompile(`^\s{0,4}(@\w+\s+)*((public|private|protected|static|final|abstract|synchronized)\s+)*(class|interface|enum|record|[\w<>\[\],\s]+\s+\w+\s*\()`),
+	"csharp":        regexp.MustCompile(`^\s{0,8}((public|private|protected|internal|static|sealed|abstract|partial|async|override|virtual)\s+)*(class|interface|enum|struct|record|namespace|[\w<>\[\],\s]+\s+\w+\s*\()`),
+	"kotlin":        regexp.MustCompile(`^((public|private|internal|protected|data|sealed|abstract|open|inline|suspend)\s+)*(fun|class|interface|object|enum class)\b`),
+	"scala":         regexp.MustCompile(`^((private|protected|final|sealed|abstract|case|implicit)\s+)*(def|class|object|trait)\b`),
+	"swift":         regexp.MustCompile(`^((public|private|internal|fileprivate|open|final)\s+)*(func|class|struct|enum|protocol|extension)\b`),
+	"rust":          regexp.MustCompile(`^(pub(\([\w:]+\))?\s+)?(async\s+)?(unsafe\s+)?(fn|struct|enum|trait|impl|mod|const|static|type|macro_rules!)`),
+	"php":           regexp.MustCompile(`^\s{0,4}((public|private|protected|static|abstract|final)\s+)*(function|class|interface|trait)\b`),
+	"c":             regexp.MustCompile(`^((struct|enum|union|typedef)\b|[A-Za-z_][\w\s\*]*\s\**\w+\s*\()`),
+	"cpp":           regexp.MustCompile(`^((struct|enum|union|typedef|class|namespace|template)\b|[A-Za-z_][\w\s\*:<>,&]*\s[\*&]*[\w:~]+\s*\()`),
+	"clojure":       regexp.MustCompile(`^\((defn|defn-|defmacro|defprotocol|defrecord|defmulti|def|ns)\s`),
+	"clojurescript": regexp.MustCompile(`^\((defn|defn-|defmacro|defprotocol|defrecord|defmulti|def|ns)\s`),
+	"julia":         regexp.MustCompile(`^(function|macro|struct|mutable struct|module|abstract type)\b`),
+	"perl":          regexp.MustCompile(`^(sub|package)\s`),
+	"r":             regexp.MustCompile(`^[\w.]+\s*(<-|=)\s*function\b`),
+	"matlab":        regexp.MustCompile(`^(function|classdef)\b`),
+	"shell":         regexp.MustCompile(`^(function\s+[\w-]+|[\w-]+\s*\(\))`),
+}
+
+// commentPrefixes are the prefixes of the lines attached to the declaration that follows them.
+var commentPrefixes = []string{"//", "#", "/*", "*", "--", ";", "%", "@", `"""`}
+
+// languageChunker splits files on the top level declarations of their language and
+// merges consecutive declarations while they fit in a chunk. Declarations that don't
+// fit and files of other languages are split into windows of tokens.
+type languageChunker struct {
+	tokens tokenChunker
+}
+
+func (c *languageChunker) Chunk(content, language string) []Chunk {
+	pattern, ok := declarationPatterns[language]
+	if !ok {
+		return c.tokens.Chunk(content, language)
+	}
+
+	chunks := make([]Chunk, 0)
+	sections := declarationSections(content, pattern)
+	start := 0
+	for i, end := range sections {
+		// Merge the next section if it still fits.
+		if i+1 < len(sections) && countTokens(content[start:sections[i+1]]) <= c.tokens.size {
+			continue
+		}
+		if strings.TrimSpace(content[start:end]) != "" {
+			if countTokens(content[start:end]) > c.tokens.size {
+				chunks = c.tokens.chunkRange(content, start, end, chunks)
+			} else {
+				chunks = append(chunks, Chunk{ID: len(chunks), StartIndex: start, EndIndex: end})
+			}
+		}
+		start = end
+	}
+	return chunks
+}
+
+// declarationSections returns the end index of every section of the content. A section
+// starts with a declaration and the comments before it.
+func declarationSections(content string, pattern *regexp.Regexp) []int {
+	lines := lineStarts(content)
+	ends := make([]int, 0)
+	previous := 0
+	for i := range lines {
+		if i == 0 || !pattern.MatchString(line(content, lines, i)) {
+			continue
+		}
+		// Attach the comments, doc strings and annotations right above the declaration.
+		first := i
+		for first > 0 && isComment(line(content, lines, first-1)) {
+			first--
+		}
+		if lines[first] > previous {
+			ends = append(ends, lines[first])
+			previous = lines[first]
+		}
+	}
+	return append(ends, len(content))
+}
+
+// line returns the i-th line of the content without its line break.
+func line(content string, lines []int, i int) string {
+	end := len(content)
+	if i+1 < len(lines) {
+		end = lines[i+1]
+	}
+	return strings.TrimRight(content[lines[i]:end], "\r\n")
+}
+
+func isComment(l string) bool {
+	l = strings.TrimSpace(l)
+	for _, prefix := range commentPrefixes {
+		if strings.HasPrefix(l, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,266 @@
+//go:build cgo
+
+package chunker
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	sitter "github.com/smacker/go-tree-sitter"
+	"github.com/smacker/go-tree-sitter/golang"
+	"github.com/smacker/go-tree-sitter/java"
+	"github.com/smacker/go-tree-sitter/javascript"
+	"github.com/smacker/go-tree-sitter/python"
+	"github.com/smacker/go-tree-sitter/rust"
+	"github.com/smacker/go-tree-sitter/typescript/tsx"
+	"github.com/smacker/go-tree-sitter/typescript/typescript"
+)
+
+// wordPattern matches the ranges between declarations worth a chunk.
+var wordPattern = regexp.MustCompile(`\w`)
+
+// syntaxLanguage defines how the syntax tree of a language is chunked.
+type syntaxLanguage struct {
+	language func() *sitter.Language
+	// declarations are the kinds of the nodes chunked on their own.
+	declarations map[string]bool
+	// comments are the kinds of the nodes attached to the declaration that follows them,
+	// e.g. doc comments and attributes.
+	comments map[string]bool
+}
+
+func kinds(names ...string) map[string]bool {
+	m := make(map[string]bool, len(names))
+	for _, name := range names {
+		m[name] = true
+	}
+	return m
+}
+
+var ecmaScriptDeclarations = kinds(
+	"function_declaration", "generator_function_declaration", "class_declaration", "abstract_class_declaration",
+	"interface_declaration", "type_alias_declaration", "enum_declaration", "lexical_declaration",
+	"variable_declaration", "export_statement", "module", "internal_module", "method_definition",
+	"public_field_definition", "method_signature", "abstract_method_signature",
+)
+
+// syntaxLanguages are the languages chunked on their syntax tree, keyed by the values
+// of the supported languages of the repository embedder.
+var syntaxLanguages = map[string]*syntaxLanguage{
+	"go": {
+		language:     golang.GetLanguage,
+		declarations: kinds("function_declaration", "method_declaration", "type_declaration", "var_declaration", "const_declaration"),
+		comments:     kinds("comment"),
+	},
+	"python": {
+		language:     python.GetLanguage,
+		declarations: kinds("function_definition", "class_definition", "decorated_definition"),
+		comments:     kinds("comment"),
+	},
+	"typescript": {
+		language:     typescript.GetLanguage,
+		declarations: ecmaScriptDeclarations,
+		comments:     kinds("comment"),
+	},
+	"tsx": {
+		language:     tsx.GetLanguage,
+		declarations: ecmaScriptDeclarations,
+		comments:     kinds("comment"),
+	},
+	"javascript": {
+		language:     javascript.GetLanguage,
+		declarations: ecmaScriptDeclarations,
+		comments:     kinds("comment"),
+	},
+	"java": {
+		language: java.GetLanguage,
+		declarations: kinds("class_declaration", "interface_declaration", "enum_declaration", "record_declaration",
+			"annotation_type_declaration", "method_declaration", "constructor_declaration", "field_declaration"),
+		comments: kinds("line_comment", "block_comment"),
+	},
+	"rust": {
+		language: rust.GetLanguage,
+		declarations: kinds("function_item", "function_signature_item", "struct_item", "enum_item", "union_item",
+			"trait_item", "impl_item", "mod_item", "const_item", "static_item", "type_item", "macro_definition"),
+		comments: kinds("line_comment", "block_comment", "attribute_item"),
+	},
+}
+
+// syntaxChunker emits a chunk per top level declaration of the syntax tree of a file,
+// with the comments right above it. Declarations that don't fit in a chunk are split
+// into their members, e.g. the methods of a class, or into windows of tokens if they
+// have none. Files that can't be parsed are split into windows of tokens and files of
+// other languages are chunked by the language chunker.
+type syntaxChunker struct {
+	tokens   tokenChunker
+	fallback Chunker
+}
+
+func newSyntaxChunker(tokens tokenChunker) Chunker {
+	return &syntaxChunker{tokens: tokens, fallback: &languageChunker{tokens: tokens}}
+}
+
+func (c *syntaxChunker) Chunk(content, language string) []Chunk {
+	lang, ok := syntaxLanguages[language]
+	if !ok {
+		return c.fallback.Chunk(content, language)
+	}
+
+	parser := sitter.NewParser()
+	defer parser.Close()
+	parser.SetLanguage(lang.language())
+
+	src := []byte(content)
+	tree, err := parser.ParseCtx(context.Background(), nil, src)
+	if err != nil {
+		return c.tokens.Chunk(content, language)
+	}
+	defer tree.Close()
+
+	root := tree.RootNode()
+	if root.HasError() {
+		return c.tokens.Chunk(content, language)
+	}
+	return c.chunkDeclarations(content, src, lang, root, 0, len(content), "", make([]Chunk, 0))
+}
+
+// chunkDeclarations appends the chunks of content[start:end] to chunks, one per
+// declaration among the children of the parent. The content between declarations is
+// chunked with the symbol of the parent.
+func (c *syntaxChunker) chunkDeclarations(content string, src []byte, lang *syntaxLanguage, parent *sitter.Node, start, end int, parentSymbol string, chunks []Chunk) []Chunk {
+	pos := start
+	for i := 0; i < int(parent.NamedChildCount()); i++ {
+		child := parent.NamedChild(i)
+		if !lang.declarations[child.Type()] {
+			continue
+		}
+
+		// Attach the comments right above the declaration.
+		first := child
+		for j := i - 1; j >= 0; j-- {
+			prev := parent.NamedChild(j)
+			if !lang.comments[prev.Type()] || int(prev.StartByte()) < pos || prev.EndPoint().Row+1 < first.StartPoint().Row {
+				break
+			}
+			first = prev
+		}
+		declStart := lineStart(content, int(first.StartByte()), pos)
+		declEnd := int(child.EndByte())
+
+		chunks = c.chunkRange(content, pos, declStart, parentSymbol, chunks)
+
+		symbol := symbolName(child, src)
+		if parentSymbol != "" && symbol != "" {
+			symbol = parentSymbol + "." + symbol
+		}
+		if countTokens(content[declStart:declEnd]) <= c.tokens.size {
+			chunks = append(chunks, Chunk{ID: len(chunks), StartIndex: declStart, EndIndex: declEnd, Symbol: symbol})
+		} else if body := declaration(child).ChildByFieldName("body"); body != nil && hasDeclarations(lang, body) {
+			chunks = c.chunkDeclarations(content, src, lang, body, declStart, declEnd, symbol, chunks)
+		} else {
+			chunks = c.chunkRange(content, declStart, declEnd, symbol, chunks)
+		}
+		pos = declEnd
+	}
+	return c.chunkRange(content, pos, end, parentSymbol, chunks)
+}
+
+// chunkRange appends content[start:end] to chunks, split into windows of tokens if it
+// doesn't fit in a chunk. Ranges without words, e.g. closing braces, are skipped.
+func (c *syntaxChunker) chunkRange(content string, start, end int, symbol string, chunks []Chunk) []Chunk {
+	if start >= end || !wordPattern.MatchString(content[start:end]) {
+		return chunks
+	}
+	if countTokens(content[start:end]) <= c.tokens.size {
+		return append(chunks, Chunk{ID: len(chunks), StartIndex: start, EndIndex: end, Symbol: symbol})
+	}
+	first := len(chunks)
+	chunks = c.tokens.chunkRange(content, start, end, chunks)
+	for i := first; i < len(chunks); i++ {
+		chunks[i].Symbol = symbol
+	}
+	return chunks
+}
+
+func hasDeclarations(lang *syntaxLanguage, n *sitter.Node) bool {
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		if lang.declarations[n.NamedChild(i).Type()] {
+			return true
+		}
+	}
+	return false
+}
+
+// declaration unwraps exported and decorated declarations.
+func declaration(n *sitter.Node) *sitter.Node {
+	for {
+		var inner *sitter.Node
+		switch n.Type() {
+		case "export_statement":
+			inner = n.ChildByFieldName("declaration")
+		case "decorated_definition":
+			inner = n.ChildByFieldName("definition")
+		}
+		if inner == nil {
+			return n
+		}
+		n = inner
+	}
+}
+
+// symbolName returns the name of the declaration, e.g. the name of a function or the
+// type and name of a method.
+func symbolName(n *sitter.Node, src []byte) string {
+	n = declaration(n)
+	switch n.Type() {
+	case "method_declaration":
+		// Go methods are named after their receiver type.
+		if receiver := n.ChildByFieldName("receiver"); receiver != nil {
+			fields := strings.Fields(strings.Trim(receiver.Content(src), "()"))
+			if len(fields) > 0 {
+				return strings.TrimLeft(fields[len(fields)-1], "*") + "." + nameOf(n, src)
+			}
+		}
+	case "impl_item":
+		if t := n.ChildByFieldName("type"); t != nil {
+			if trait := n.ChildByFieldName("trait"); trait != nil {
+				return trait.Content(src) + " for " + t.Content(src)
+			}
+			return t.Content(src)
+		}
+	}
+	if name := nameOf(n, src); name != "" {
+		return name
+	}
+	// Declarations of variables, constants and types hold the names in their children.
+	for i := 0; i < int(n.NamedChildCount()); i++ {
+		child := n.NamedChild(i)
+		if name := nameOf(child, src); name != "" {
+			return name
+		}
+		if declarator := child.ChildByFieldName("declarator"); declarator != nil {
+			if name := nameOf(declarator, src); name != "" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+func nameOf(n *sitter.Node, src []byte) string {
+	if name := n.ChildByFieldName("name"); name != nil {
+		return name.Content(src)
+	}
+	return ""
+}
+
+// lineStart moves the index to the start of its line if only indentation precedes it,
+// without going before min.
+func lineStart(content string, index, min int) int {
+	start := strings.LastIndexByte(content[:index], '\n') + 1
+	if start < min || strings.TrimSpace(content[start:index]) != "" {
+		return index
+	}
+	return start
+}
@@ -0,0 +1,9 @@
+//go:build !cgo
+
+package chunker
+
+// newSyntaxChunker falls back to the language chunker since the tree-sitter parsers
+// require cgo.
+func newSyntaxChunker(tokens tokenChunker) Chunker {
+	return &languageChunker{tokens: tokens}
+}
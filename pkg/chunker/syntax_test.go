@@ -0,0 +1,106 @@
+//go:build cgo
+
+package chunker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSyntaxChunker(t *testing.T) {
+	type chunk struct {
+		text   string
+		symbol string
+	}
+	tests := []struct {
+		name     string
+		size     int
+		language string
+		content  string
+		want     []chunk
+	}{
+		{
+			name: "go declarations with their doc comments", size: 64, language: "go",
+			content: "package main\n\nimport \"fmt\"\n\n// Point is a point.\ntype Point struct{ X, Y int }\n\n// String formats the point.\nfunc (p *Point) String() string {\n\treturn fmt.Sprint(p.X, p.Y)\n}\n\nvar origin = Point{}\n",
+			want: []chunk{
+				{text: "package main\n\nimport \"fmt\"\n\n"},
+				{text: "// Point is a point.\ntype Point struct{ X, Y int }", symbol: "Point"},
+				{text: "// String formats the point.\nfunc (p *Point) String() string {\n\treturn fmt.Sprint(p.X, p.Y)\n}", symbol: "Point.String"},
+				{text: "var origin = Point{}", symbol: "origin"},
+			},
+		},
+		{
+			name: "python decorated definitions", size: 64, language: "python",
+			content: "import os\n\n@cached\ndef load(path):\n    return os.path.basename(path)\n",
+			want: []chunk{
+				{text: "import os\n\n"},
+				{text: "@cached\ndef load(path):\n    return os.path.basename(path)", symbol: "load"},
+			},
+		},
+		{
+			name: "class split into its methods", size: 18, language: "python",
+			content: "class Store:\n    def get(self, key):\n        return self.items[key]\n\n    def put(self, key, value):\n        self.items[key] = value\n",
+			want: []chunk{
+				{text: "class Store:\n", symbol: "Store"},
+				{text: "    def get(self, key):\n        return self.items[key]", symbol: "Store.get"},
+				{text: "    def put(self, key, value):\n        self.items[key] = value", symbol: "Store.put"},
+			},
+		},
+		{
+			name: "rust trait impls", size: 64, language: "rust",
+			content: "/// A point.\n#[derive(Debug)]\nstruct Point(i32, i32);\n\nimpl fmt::Display for Point {\n    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result { Ok(()) }\n}\n",
+			want: []chunk{
+				{text: "/// A point.\n#[derive(Debug)]\nstruct Point(i32, i32);", symbol: "Point"},
+				{text: "impl fmt::Display for Point {\n    fn fmt(&self, f: &mut fmt::Formatter) -> fmt::Result { Ok(()) }\n}", symbol: "fmt::Display for Point"},
+			},
+		},
+		{
+			name: "typescript exports", size: 64, language: "typescript",
+			content: "export interface User {\n  name: string\n}\n\nexport const greet = (u: User): string => `hi ${u.name}`\n",
+			want: []chunk{
+				{text: "export interface User {\n  name: string\n}", symbol: "User"},
+				{text: "export const greet = (u: User): string => `hi ${u.name}`", symbol: "greet"},
+			},
+		},
+		{
+			name: "tsx components", size: 64, language: "tsx",
+			content: "import React from 'react'\n\nexport function App({ title }: Props) {\n  return <h1 className=\"title\">{title}</h1>\n}\n",
+			want: []chunk{
+				{text: "import React from 'react'\n\n"},
+				{text: "export function App({ title }: Props) {\n  return <h1 className=\"title\">{title}</h1>\n}", symbol: "App"},
+			},
+		},
+		{
+			name: "declaration split into windows of tokens", size: 6, language: "go",
+			content: "func Sum(a, b int) int {\n\treturn a + b\n}\n",
+			want: []chunk{
+				{text: "func Sum(a, b", symbol: "Sum"},
+				{text: "b int) int {\n\treturn", symbol: "Sum"},
+				{text: "return a + b\n}", symbol: "Sum"},
+			},
+		},
+		{
+			name: "file with syntax errors is split into windows of tokens", size: 4, language: "go",
+			content: "func (",
+			want:    []chunk{{text: "func ("}},
+		},
+		{
+			name: "language without grammar uses the language chunker", size: 64, language: "ruby",
+			content: "def a\n  1\nend\n",
+			want:    []chunk{{text: "def a\n  1\nend\n"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newSyntaxChunker(tokenChunker{size: tt.size, overlap: 1})
+			chunks := c.Chunk(tt.content, tt.language)
+			got := make([]chunk, 0, len(chunks))
+			for i, text := range texts(t, tt.content, chunks) {
+				got = append(got, chunk{text: text, symbol: chunks[i].Symbol})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Chunk() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ import "fmt"
 const (
 	// AdminClientKey is the key used to store the admin client in the context
 	AdminClientKey = "adminClient"
+	// AdminClientsetKey is the key used to store the admin clientset in the context,
+	// it is used for subresources like pod logs the admin client can't read
+	AdminClientsetKey = "adminClientset"
 )
 
 func ModelServiceURL(modelId string, namespace string) string {
@@ -14,3 +17,7 @@ func ModelServiceURL(modelId string, namespace string) string {
 func RedisServiceURL(redisId string, namespace string) string {
 	return fmt.Sprintf("%s.%s.svc.cluster.local:6379", redisId, namespace)
 }
+
+func ElasticsearchServiceURL(elasticsearchId string, namespace string) string {
+	return fmt.Sprintf("http://%s.%s.svc.cluster.local:9200", elasticsearchId, namespace)
+}
@@ -12,6 +12,9 @@ import (
 	"gorm.io/gorm/logger"
 )
 
+// defaultDimension is the dimension of the code_embeddings table.
+const defaultDimension = 768
+
 var (
 	mu        sync.Mutex
 	instances = make(map[string]*gorm.DB)
@@ -51,6 +54,45 @@ func GetPostgresClient(dsn string) (*gorm.DB, error) {
 	return db, nil
 }
 
+// CodeEmbeddingsTable returns the table storing the code embeddings of the given
+// dimension. Embeddings of the default dimension are stored in code_embeddings.
+func CodeEmbeddingsTable(dimension int) string {
+	if dimension == defaultDimension {
+		return "code_embeddings"
+	}
+	return fmt.Sprintf("code_embeddings_%d", dimension)
+}
+
+// MigrateCodeEmbeddings creates the code embeddings table of the given dimension
+// from the code_embeddings table if it doesn't exist.
+func MigrateCodeEmbeddings(db *gorm.DB, dimension int) error {
+	table := CodeEmbeddingsTable(dimension)
+	if db.Migrator().HasTable(table) {
+		// Add the columns added to code_embeddings after the table was created.
+		for _, column := range []string{"symbol text", "refs text[]"} {
+			if err := db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s", table, column)).Error; err != nil {
+				return err
+			}
+		}
+		// Symbols were limited to 255 characters, which long signatures exceed. Altering
+		// the type rewrites the table, so it's only done while it's still limited.
+		var dataType string
+		if err := db.Raw("SELECT data_type FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = ? AND column_name = 'symbol'",
+			table).Scan(&dataType).Error; err != nil {
+			return err
+		}
+		if dataType != "character varying" {
+			return nil
+		}
+		return db.Exec(fmt.Sprintf("ALTER TABLE %s ALTER COLUMN symbol TYPE text", table)).Error
+	}
+	// The shards of an execution migrate concurrently, the table may have been created since.
+	if err := db.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (LIKE code_embeddings INCLUDING ALL)", table)).Error; err != nil {
+		return err
+	}
+	return db.Exec(fmt.Sprintf("ALTER TABLE %s ALTER COLUMN embedding TYPE vector(%d)", table, dimension)).Error
+}
+
 // ConstructPostgresDSN constructs the Data Source Name for a PostgreSQL connection
 func ConstructPostgresDSN(host, user, password, dbname, port, sslmode, timezone string) string {
 	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s TimeZone=%s",
@@ -96,4 +138,6 @@ type CodeEmbedding struct {
 	StartIndex int
 	EndIndex   int
 	Embedding  pgvector.Vector `gorm:"type:vector(768)"`
+	Symbol     string          `gorm:"type:text"`
+	Refs       pq.StringArray  `gorm:"type:text[]"`
 }
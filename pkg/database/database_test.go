@@ -0,0 +1,18 @@
+package database
+
+import "testing"
+
+func TestCodeEmbeddingsTable(t *testing.T) {
+	tests := []struct {
+		dimension int
+		want      string
+	}{
+		{dimension: 768, want: "code_embeddings"},
+		{dimension: 1536, want: "code_embeddings_1536"},
+	}
+	for _, tt := range tests {
+		if got := CodeEmbeddingsTable(tt.dimension); got != tt.want {
+			t.Errorf("CodeEmbeddingsTable(%d) = %q, want %q", tt.dimension, got, tt.want)
+		}
+	}
+}
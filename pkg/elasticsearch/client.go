@@ -0,0 +1,364 @@
+package elasticsearch
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/encoder-run/operator/pkg/common"
+)
+
+var (
+	// ErrNotFound is returned when a document does not exist.
+	ErrNotFound = errors.New("document not found")
+	// ErrConflict is returned when a conditional write lost against a concurrent update.
+	ErrConflict = errors.New("document version conflict")
+)
+
+// Client for the Elasticsearch REST API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	username   string
+	password   string
+}
+
+// Document is a document returned by the get API.
+type Document struct {
+	ID          string          `json:"_id"`
+	Found       bool            `json:"found"`
+	SeqNo       int64           `json:"_seq_no"`
+	PrimaryTerm int64           `json:"_primary_term"`
+	Source      json.RawMessage `json:"_source"`
+}
+
+// Hit is a single hit returned by the search API.
+type Hit struct {
+	ID     string          `json:"_id"`
+	Score  float64         `json:"_score"`
+	Source json.RawMessage `json:"_source"`
+	Sort   []interface{}   `json:"sort"`
+}
+
+// SearchResponse is the response of the search API.
+type SearchResponse struct {
+	Hits struct {
+		Hits []Hit `json:"hits"`
+	} `json:"hits"`
+}
+
+// BulkDocument is a document indexed by the bulk API.
+type BulkDocument struct {
+	ID  string
+	Doc interface{}
+}
+
+// NewClient creates a new client for the Elasticsearch cluster at baseURL.
+func NewClient(baseURL, username, password string) *Client {
+	return &Client{
+		httpClient: &http.Client{},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		username:   username,
+		password:   password,
+	}
+}
+
+// NewServiceClient creates a new client for a storage deployed in the cluster.
+func NewServiceClient(storageId, namespace, username, password string) *Client {
+	return NewClient(common.ElasticsearchServiceURL(storageId, namespace), username, password)
+}
+
+// CreateIndex creates the index with the given settings and mappings. It is a
+// no-op if the index already exists.
+func (c *Client) CreateIndex(index string, body interface{}) error {
+	resp, err := c.do(http.MethodPut, "/"+url.PathEscape(index), body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusBadRequest {
+		var result struct {
+			Error struct {
+				Type string `json:"type"`
+			} `json:"error"`
+		}
+		data, _ := io.ReadAll(resp.Body)
+		if err := json.Unmarshal(data, &result); err == nil && result.Error.Type == "resource_already_exists_exception" {
+			return nil
+		}
+		return fmt.Errorf("failed to create index %s: %s", index, string(data))
+	}
+	return checkResponse(resp)
+}
+
+// PutMapping adds fields to the mapping of an index.
+func (c *Client) PutMapping(index string, body interface{}) error {
+	resp, err := c.do(http.MethodPut, "/"+url.PathEscape(index)+"/_mapping", body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+// Index creates or replaces the document with the given id.
+func (c *Client) Index(index, id string, doc interface{}) error {
+	resp, err := c.do(http.MethodPut, docPath(index, id), doc)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+// IndexIfMatch replaces the document only if it was not modified since it was
+// read with the given sequence number and primary term.
+func (c *Client) IndexIfMatch(index, id string, doc interface{}, seqNo, primaryTerm int64) error {
+	p := fmt.Sprintf("%s?if_seq_no=%d&if_primary_term=%d", docPath(index, id), seqNo, primaryTerm)
+	resp, err := c.do(http.MethodPut, p, doc)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+// Create indexes the document only if no document with the given id exists.
+func (c *Client) Create(index, id string, doc interface{}) error {
+	p := fmt.Sprintf("/%s/_create/%s", url.PathEscape(index), url.PathEscape(id))
+	resp, err := c.do(http.MethodPut, p, doc)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+// Get retrieves the document with the given id.
+func (c *Client) Get(index, id string) (*Document, error) {
+	resp, err := c.do(http.MethodGet, docPath(index, id), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var doc Document
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, err
+	}
+	if !doc.Found {
+		return nil, ErrNotFound
+	}
+	return &doc, nil
+}
+
+// Exists checks if the document with the given id exists.
+func (c *Client) Exists(index, id string) (bool, error) {
+	resp, err := c.do(http.MethodHead, docPath(index, id), nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// Delete deletes the document with the given id.
+func (c *Client) Delete(index, id string) error {
+	resp, err := c.do(http.MethodDelete, docPath(index, id), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+// DeleteByQuery deletes all documents matching the query.
+func (c *Client) DeleteByQuery(index string, query interface{}) error {
+	p := fmt.Sprintf("/%s/_delete_by_query?conflicts=proceed", url.PathEscape(index))
+	resp, err := c.do(http.MethodPost, p, map[string]interface{}{"query": query})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+// UpdateByQuery runs the script on all documents matching the query and returns the
+// number of matching documents. Scripts can skip or delete a document by setting
+// ctx.op to noop or delete.
+func (c *Client) UpdateByQuery(index string, query, script interface{}) (int, error) {
+	p := fmt.Sprintf("/%s/_update_by_query?conflicts=proceed&refresh=true", url.PathEscape(index))
+	resp, err := c.do(http.MethodPost, p, map[string]interface{}{"query": query, "script": script})
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		Total int `json:"total"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	return result.Total, nil
+}
+
+// Refresh makes all operations on the index visible to searches.
+func (c *Client) Refresh(index string) error {
+	resp, err := c.do(http.MethodPost, fmt.Sprintf("/%s/_refresh", url.PathEscape(index)), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+// Search runs the search request against the index.
+func (c *Client) Search(index string, body interface{}) (*SearchResponse, error) {
+	resp, err := c.do(http.MethodPost, fmt.Sprintf("/%s/_search", url.PathEscape(index)), body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		// A missing index has no documents.
+		if errors.Is(err, ErrNotFound) {
+			return &SearchResponse{}, nil
+		}
+		return nil, err
+	}
+
+	var result SearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Bulk creates or replaces all documents in the index with a single request.
+func (c *Client) Bulk(index string, docs []BulkDocument) error {
+	if len(docs) == 0 {
+		return nil
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	for _, d := range docs {
+		action := map[string]interface{}{"index": map[string]string{"_index": index, "_id": d.ID}}
+		if err := enc.Encode(action); err != nil {
+			return err
+		}
+		if err := enc.Encode(d.Doc); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, c.baseURL+"/_bulk", buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := c.send(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return err
+	}
+
+	// The bulk API succeeds even if single documents fail.
+	var result struct {
+		Errors bool `json:"errors"`
+		Items  []map[string]struct {
+			ID    string          `json:"_id"`
+			Error json.RawMessage `json:"error"`
+		} `json:"items"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return err
+	}
+	if result.Errors {
+		for _, item := range result.Items {
+			for _, r := range item {
+				if len(r.Error) > 0 {
+					return fmt.Errorf("failed to index document %s: %s", r.ID, string(r.Error))
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Ping checks that the cluster is reachable with the given credentials.
+func (c *Client) Ping() error {
+	resp, err := c.do(http.MethodGet, "/", nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+func (c *Client) do(method, path string, body interface{}) (*http.Response, error) {
+	var r io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewBuffer(payload)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+path, r)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return c.send(req)
+}
+
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	return c.httpClient.Do(req)
+}
+
+// checkResponse converts unsuccessful responses to errors.
+func checkResponse(resp *http.Response) error {
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return ErrNotFound
+	case resp.StatusCode == http.StatusConflict:
+		return ErrConflict
+	case resp.StatusCode >= 300:
+		data, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("elasticsearch request failed with status %d: %s", resp.StatusCode, string(data))
+	}
+	return nil
+}
+
+func docPath(index, id string) string {
+	return fmt.Sprintf("/%s/_doc/%s", url.PathEscape(index), url.PathEscape(id))
+}
@@ -0,0 +1,166 @@
+package elasticsearch
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// respond returns a server answering every request with the status and body.
+func respond(t *testing.T, status int, body string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestCreateIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{name: "created", status: http.StatusOK, body: `{"acknowledged":true}`},
+		{
+			name:   "already exists",
+			status: http.StatusBadRequest,
+			body:   `{"error":{"type":"resource_already_exists_exception"},"status":400}`,
+		},
+		{
+			name:    "invalid mapping",
+			status:  http.StatusBadRequest,
+			body:    `{"error":{"type":"mapper_parsing_exception"},"status":400}`,
+			wantErr: true,
+		},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(respond(t, tt.status, tt.body).URL, "", "")
+			if err := c.CreateIndex("index", CodeEmbeddingsMapping(768)); (err != nil) != tt.wantErr {
+				t.Errorf("CreateIndex() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResponseErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{name: "missing document", status: http.StatusNotFound, want: ErrNotFound},
+		{name: "document not found", status: http.StatusOK, body: `{"_id":"id","found":false}`, want: ErrNotFound},
+		{name: "version conflict", status: http.StatusConflict, want: ErrConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(respond(t, tt.status, tt.body).URL, "", "")
+			if _, err := c.Get("index", "id"); !errors.Is(err, tt.want) {
+				t.Errorf("Get() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestExists(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		want    bool
+		wantErr bool
+	}{
+		{name: "existing document", status: http.StatusOK, want: true},
+		{name: "missing document", status: http.StatusNotFound, want: false},
+		{name: "failed request", status: http.StatusInternalServerError, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(respond(t, tt.status, "").URL, "", "")
+			got, err := c.Exists("index", "id")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Exists() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Exists() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchMissingIndex(t *testing.T) {
+	c := NewClient(respond(t, http.StatusNotFound, `{"error":{"type":"index_not_found_exception"}}`).URL, "", "")
+	got, err := c.Search("index", map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(got.Hits.Hits) != 0 {
+		t.Errorf("Search() hits = %v, want none", got.Hits.Hits)
+	}
+}
+
+func TestBulk(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{name: "indexed", body: `{"errors":false,"items":[{"index":{"_id":"a"}},{"index":{"_id":"b"}}]}`},
+		{
+			name:    "failed document",
+			body:    `{"errors":true,"items":[{"index":{"_id":"a"}},{"index":{"_id":"b","error":{"type":"mapper_parsing_exception"}}}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lines []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if user, password, ok := r.BasicAuth(); !ok || user != "elastic" || password != "secret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				scanner := bufio.NewScanner(r.Body)
+				for scanner.Scan() {
+					lines = append(lines, scanner.Text())
+				}
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL+"/", "elastic", "secret")
+			docs := []BulkDocument{{ID: "a", Doc: Blob{URL: "a"}}, {ID: "b", Doc: Blob{URL: "b"}}}
+			if err := c.Bulk("index", docs); (err != nil) != tt.wantErr {
+				t.Fatalf("Bulk() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			// Every document is preceded by its action.
+			if len(lines) != 4 {
+				t.Fatalf("Bulk() sent %d lines, want 4", len(lines))
+			}
+			var action map[string]map[string]string
+			if err := json.Unmarshal([]byte(lines[2]), &action); err != nil {
+				t.Fatal(err)
+			}
+			if action["index"]["_index"] != "index" || action["index"]["_id"] != "b" {
+				t.Errorf("Bulk() action = %v, want index b", action)
+			}
+		})
+	}
+}
+
+func TestBulkWithoutDocuments(t *testing.T) {
+	c := NewClient("http://127.0.0.1:0", "", "")
+	if err := c.Bulk("index", nil); err != nil {
+		t.Errorf("Bulk() error = %v", err)
+	}
+}
@@ -0,0 +1,150 @@
+package elasticsearch
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// defaultDimension is the dimension of the embeddings of models that don't declare one.
+const defaultDimension = 768
+
+const (
+	// ObjectsIndex stores the git objects of all repositories.
+	ObjectsIndex = "git-objects"
+	// ReferencesIndex stores the git references of all repositories.
+	ReferencesIndex = "git-references"
+	// ConfigIndex stores the git config of all repositories.
+	ConfigIndex = "git-config"
+	// ShallowIndex stores the shallow commits of all repositories.
+	ShallowIndex = "git-shallow"
+	// IndexIndex stores the git index of all repositories.
+	IndexIndex = "git-index"
+)
+
+// CodeEmbeddingsIndex returns the index storing the code embeddings of the given
+// dimension of all repositories. Embeddings of the default dimension are stored in
+// code-embeddings.
+func CodeEmbeddingsIndex(dimension int) string {
+	if dimension == defaultDimension {
+		return "code-embeddings"
+	}
+	return fmt.Sprintf("code-embeddings-%d", dimension)
+}
+
+// Object is a git object of a repository.
+type Object struct {
+	URL  string `json:"url"`
+	Hash string `json:"hash"`
+	Type string `json:"type"`
+	Size int64  `json:"size"`
+	Blob []byte `json:"blob"`
+}
+
+// Reference is a git reference of a repository.
+type Reference struct {
+	URL    string `json:"url"`
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Target string `json:"target"`
+	Hash   string `json:"hash"`
+}
+
+// Blob is a serialized config, index or shallow list of a repository.
+type Blob struct {
+	URL  string `json:"url"`
+	Blob []byte `json:"blob"`
+}
+
+// CodeEmbedding is the embedding of a single chunk of a file.
+type CodeEmbedding struct {
+	URL        string    `json:"url"`
+	FileHash   string    `json:"fileHash"`
+	FilePath   string    `json:"filePath"`
+	ChunkID    int       `json:"chunkID"`
+	StartIndex int       `json:"startIndex"`
+	EndIndex   int       `json:"endIndex"`
+	Embedding  []float32 `json:"embedding,omitempty"`
+	Symbol     string    `json:"symbol,omitempty"`
+	Refs       []string  `json:"refs,omitempty"`
+}
+
+// DocumentID joins the parts of a document id, e.g. the repository URL and the object hash.
+func DocumentID(parts ...string) string {
+	return strings.Join(parts, ":")
+}
+
+// CodeEmbeddingID returns the id of a chunk. File paths can be longer than the ids
+// allowed by Elasticsearch so the path is hashed.
+func CodeEmbeddingID(url, fileHash, filePath string, chunkID int) string {
+	sum := sha1.Sum([]byte(filePath))
+	return DocumentID(url, fileHash, hex.EncodeToString(sum[:]), fmt.Sprint(chunkID))
+}
+
+// GitIndices returns the mappings of the indices used by the go-git storage.
+func GitIndices() map[string]interface{} {
+	blob := map[string]interface{}{"type": "binary"}
+	keyword := map[string]interface{}{"type": "keyword"}
+	return map[string]interface{}{
+		ObjectsIndex: mappings(map[string]interface{}{
+			"url":  keyword,
+			"hash": keyword,
+			"type": keyword,
+			"size": map[string]interface{}{"type": "long"},
+			"blob": blob,
+		}),
+		ReferencesIndex: mappings(map[string]interface{}{
+			"url":    keyword,
+			"name":   keyword,
+			"type":   keyword,
+			"target": keyword,
+			"hash":   keyword,
+		}),
+		ConfigIndex:  mappings(map[string]interface{}{"url": keyword, "blob": blob}),
+		ShallowIndex: mappings(map[string]interface{}{"url": keyword, "blob": blob}),
+		IndexIndex:   mappings(map[string]interface{}{"url": keyword, "blob": blob}),
+	}
+}
+
+// CodeEmbeddingsMapping returns the mapping of the code embeddings index of the given dimension.
+func CodeEmbeddingsMapping(dimension int) map[string]interface{} {
+	keyword := map[string]interface{}{"type": "keyword"}
+	integer := map[string]interface{}{"type": "integer"}
+	return mappings(map[string]interface{}{
+		"url":        keyword,
+		"symbol":     keyword,
+		"refs":       keyword,
+		"fileHash":   keyword,
+		"filePath":   keyword,
+		"chunkID":    integer,
+		"startIndex": integer,
+		"endIndex":   integer,
+		"embedding": map[string]interface{}{
+			"type":       "dense_vector",
+			"dims":       dimension,
+			"index":      true,
+			"similarity": "cosine",
+		},
+	})
+}
+
+// CodeEmbeddingsAddedFields returns the mapping of the fields added to the code
+// embeddings index after it was first released.
+func CodeEmbeddingsAddedFields() map[string]interface{} {
+	return map[string]interface{}{
+		"properties": map[string]interface{}{
+			"symbol": map[string]interface{}{"type": "keyword"},
+			"refs":   map[string]interface{}{"type": "keyword"},
+		},
+	}
+}
+
+func mappings(properties map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"mappings": map[string]interface{}{
+			"dynamic":    "strict",
+			"properties": properties,
+		},
+	}
+}
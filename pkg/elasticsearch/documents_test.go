@@ -0,0 +1,35 @@
+package elasticsearch
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCodeEmbeddingID(t *testing.T) {
+	long := strings.Repeat("directory/", 100) + "main.go"
+	id := CodeEmbeddingID("github.com/encoder-run/operator", "abc", long, 2)
+	if !strings.HasPrefix(id, "github.com/encoder-run/operator:abc:") || !strings.HasSuffix(id, ":2") {
+		t.Errorf("CodeEmbeddingID() = %q, want the URL, file hash and chunk", id)
+	}
+	if len(id) > 512 {
+		t.Errorf("CodeEmbeddingID() has %d bytes, want at most 512", len(id))
+	}
+	if other := CodeEmbeddingID("github.com/encoder-run/operator", "abc", "main.go", 2); other == id {
+		t.Errorf("CodeEmbeddingID() = %q for different paths", id)
+	}
+}
+
+func TestCodeEmbeddingsIndex(t *testing.T) {
+	tests := []struct {
+		dimension int
+		want      string
+	}{
+		{dimension: 768, want: "code-embeddings"},
+		{dimension: 1536, want: "code-embeddings-1536"},
+	}
+	for _, tt := range tests {
+		if got := CodeEmbeddingsIndex(tt.dimension); got != tt.want {
+			t.Errorf("CodeEmbeddingsIndex(%d) = %q, want %q", tt.dimension, got, tt.want)
+		}
+	}
+}
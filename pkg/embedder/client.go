@@ -2,22 +2,42 @@ package embedder
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
+	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/chunker"
 	"github.com/encoder-run/operator/pkg/common"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// textBatchSize is the maximum number of texts sent in a single request to models
+// that chunk files themselves.
+const textBatchSize = 32
+
 // Client for embedding source code files
 type EmbeddingClient struct {
 	httpClient *http.Client
 	baseURL    string
+	// authHeader is set to authValue on every request if both are set.
+	authHeader string
+	authValue  string
+	// openAI is set when the model is served by an OpenAI compatible API.
+	openAI *openAIConfig
 }
 
 type CodeEmbeddingRequest struct {
 	Path    string
 	Content string
 	Hash    string
+	// Language of the file used by language aware chunking, empty if unknown
+	Language string
 }
 
 type CodeEmbeddingChunk struct {
@@ -27,6 +47,8 @@ type CodeEmbeddingChunk struct {
 	StartIndex int       `json:"start_index"`
 	EndIndex   int       `json:"end_index"`
 	Embedding  []float32 `json:"embedding"`
+	// Symbol is the name of the declaration of the chunk, only set by syntax aware chunking
+	Symbol string `json:"symbol,omitempty"`
 }
 
 type CodeEmbeddings struct {
@@ -45,8 +67,93 @@ func NewClient(modelId, namespace string) *EmbeddingClient {
 	}
 }
 
+// NewModelClient creates a new client for fetching embeddings from the model.
+func NewModelClient(ctx context.Context, c client.Client, model *v1alpha1.Model) (*EmbeddingClient, error) {
+	switch model.Spec.Type {
+	case v1alpha1.ModelTypeHuggingFace:
+		return NewClient(model.Name, model.Namespace), nil
+	case v1alpha1.ModelTypeOpenAI:
+		if model.Spec.OpenAI == nil {
+			return nil, fmt.Errorf("openai spec not found for model %s", model.Name)
+		}
+		token, err := modelToken(ctx, c, model)
+		if err != nil {
+			return nil, err
+		}
+		return NewOpenAIClient(model.Spec.OpenAI.BaseURL, model.Spec.OpenAI.Model, token), nil
+	case v1alpha1.ModelTypeExternal:
+		if model.Spec.External == nil {
+			return nil, fmt.Errorf("external spec not found for model %s", model.Name)
+		}
+		token, err := modelToken(ctx, c, model)
+		if err != nil {
+			return nil, err
+		}
+		return NewExternalClient(model.Spec.External, token)
+	default:
+		return nil, fmt.Errorf("unsupported model type: %s", model.Spec.Type)
+	}
+}
+
+// NewExternalClient creates a new client for fetching embeddings from an existing
+// endpoint. The token is sent as the value of the auth header if set.
+func NewExternalClient(spec *v1alpha1.ExternalModelSpec, token string) (*EmbeddingClient, error) {
+	authHeader := spec.AuthHeader
+	if authHeader == "" {
+		authHeader = "Authorization"
+	}
+	ec := &EmbeddingClient{
+		httpClient: &http.Client{},
+		baseURL:    spec.Endpoint,
+		authHeader: authHeader,
+		authValue:  token,
+	}
+	switch spec.Format {
+	case v1alpha1.ExternalModelFormatEncoder, "":
+	case v1alpha1.ExternalModelFormatOpenAI:
+		ec.openAI = &openAIConfig{model: spec.Model}
+	default:
+		return nil, fmt.Errorf("unsupported external model format: %s", spec.Format)
+	}
+	return ec, nil
+}
+
+// modelToken returns the token of the secret with the name of the model. The token
+// is optional since local servers usually don't require one.
+func modelToken(ctx context.Context, c client.Client, model *v1alpha1.Model) (string, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: model.Name, Namespace: model.Namespace}, secret); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	return string(secret.Data["token"]), nil
+}
+
+// Probe sends a small request to the model to check that it returns embeddings.
+func (ec *EmbeddingClient) Probe(timeout time.Duration) error {
+	probe := *ec
+	probe.httpClient = &http.Client{Timeout: timeout}
+	response, err := probe.FetchEmbeddings([]CodeEmbeddingRequest{
+		{
+			Path:    "probe",
+			Content: "probe",
+			Hash:    "probe",
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(response.Results["probe"].Embeddings) == 0 {
+		return fmt.Errorf("no embeddings returned by %s", ec.baseURL)
+	}
+	return nil
+}
+
 // FetchEmbeddings sends a batch of file content to the inference API and retrieves embeddings.
 func (ec *EmbeddingClient) FetchEmbeddings(requests []CodeEmbeddingRequest) (*CodeEmbeddingsResponse, error) {
+	if ec.openAI != nil {
+		return ec.fetchOpenAIEmbeddings(requests)
+	}
+
 	instances := make([]map[string]string, 0)
 	for _, f := range requests {
 		instances = append(instances, map[string]string{"file_path": f.Path, "code": f.Content, "file_hash": f.Hash})
@@ -56,7 +163,7 @@ func (ec *EmbeddingClient) FetchEmbeddings(requests []CodeEmbeddingRequest) (*Co
 		return nil, err
 	}
 
-	resp, err := ec.httpClient.Post(ec.baseURL, "application/json", bytes.NewBuffer(payload))
+	resp, err := ec.post(payload)
 	if err != nil {
 		return nil, err
 	}
@@ -68,3 +175,114 @@ func (ec *EmbeddingClient) FetchEmbeddings(requests []CodeEmbeddingRequest) (*Co
 	}
 	return &result, nil
 }
+
+// FetchChunkEmbeddings splits the files into chunks with the chunker and retrieves the
+// embeddings of the chunks, so the chunks don't depend on the model.
+func (ec *EmbeddingClient) FetchChunkEmbeddings(requests []CodeEmbeddingRequest, c chunker.Chunker) (*CodeEmbeddingsResponse, error) {
+	type chunkRef struct {
+		path  string
+		chunk CodeEmbeddingChunk
+	}
+	refs := make([]chunkRef, 0)
+	for _, f := range requests {
+		for _, ch := range c.Chunk(f.Content, f.Language) {
+			refs = append(refs, chunkRef{path: f.Path, chunk: CodeEmbeddingChunk{
+				ChunkID:    ch.ID,
+				FileHash:   f.Hash,
+				Code:       f.Content[ch.StartIndex:ch.EndIndex],
+				StartIndex: ch.StartIndex,
+				EndIndex:   ch.EndIndex,
+				Symbol:     ch.Symbol,
+			}})
+		}
+	}
+
+	texts := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		texts = append(texts, ref.chunk.Code)
+	}
+	embeddings, err := ec.EmbedTexts(texts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &CodeEmbeddingsResponse{Results: make(map[string]CodeEmbeddings)}
+	for i, ref := range refs {
+		ref.chunk.Embedding = embeddings[i]
+		embs := result.Results[ref.path]
+		embs.Embeddings = append(embs.Embeddings, ref.chunk)
+		result.Results[ref.path] = embs
+	}
+	return result, nil
+}
+
+// EmbedTexts returns the embeddings of the texts in order. Models that chunk files
+// themselves may split a long text, the embeddings of its chunks are then averaged.
+func (ec *EmbeddingClient) EmbedTexts(texts []string) ([][]float32, error) {
+	if ec.openAI != nil {
+		return ec.embedOpenAITexts(texts)
+	}
+
+	requests := make([]CodeEmbeddingRequest, 0, len(texts))
+	for i, text := range texts {
+		requests = append(requests, CodeEmbeddingRequest{Path: strconv.Itoa(i), Content: text, Hash: strconv.Itoa(i)})
+	}
+	embeddings := make([][]float32, 0, len(texts))
+	for start := 0; start < len(requests); start += textBatchSize {
+		end := start + textBatchSize
+		if end > len(requests) {
+			end = len(requests)
+		}
+		response, err := ec.FetchEmbeddings(requests[start:end])
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range requests[start:end] {
+			embs := response.Results[r.Path].Embeddings
+			if len(embs) == 0 {
+				return nil, fmt.Errorf("no embeddings returned for text %s", r.Path)
+			}
+			embeddings = append(embeddings, meanEmbedding(embs))
+		}
+	}
+	return embeddings, nil
+}
+
+// meanEmbedding averages the embeddings of the chunks.
+func meanEmbedding(chunks []CodeEmbeddingChunk) []float32 {
+	if len(chunks) == 1 {
+		return chunks[0].Embedding
+	}
+	mean := make([]float32, len(chunks[0].Embedding))
+	for _, c := range chunks {
+		for i := range mean {
+			if i < len(c.Embedding) {
+				mean[i] += c.Embedding[i] / float32(len(chunks))
+			}
+		}
+	}
+	return mean
+}
+
+// post sends the JSON payload to the model and returns the response if it succeeded.
+func (ec *EmbeddingClient) post(payload []byte) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, ec.baseURL, bytes.NewBuffer(payload))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if ec.authHeader != "" && ec.authValue != "" {
+		req.Header.Set(ec.authHeader, ec.authValue)
+	}
+
+	resp, err := ec.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("embeddings request failed with status %d: %s", resp.StatusCode, string(body))
+	}
+	return resp, nil
+}
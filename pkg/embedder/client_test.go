@@ -0,0 +1,238 @@
+package embedder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/chunker"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewModelClient(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           v1alpha1.ModelSpec
+		token          string
+		wantBaseURL    string
+		wantAuthHeader string
+		wantAuthValue  string
+		wantOpenAI     bool
+		wantErr        bool
+	}{
+		{
+			name:        "openai without a token",
+			spec:        v1alpha1.ModelSpec{Type: v1alpha1.ModelTypeOpenAI, OpenAI: &v1alpha1.OpenAIModelSpec{Model: "text-embedding-3-small"}},
+			wantBaseURL: DefaultOpenAIBaseURL + "/embeddings",
+			wantOpenAI:  true,
+		},
+		{
+			name:           "openai with a token",
+			spec:           v1alpha1.ModelSpec{Type: v1alpha1.ModelTypeOpenAI, OpenAI: &v1alpha1.OpenAIModelSpec{BaseURL: "http://localhost:8080/v1/", Model: "model"}},
+			token:          "token",
+			wantBaseURL:    "http://localhost:8080/v1/embeddings",
+			wantAuthHeader: "Authorization",
+			wantAuthValue:  "Bearer token",
+			wantOpenAI:     true,
+		},
+		{
+			name:           "external encoder",
+			spec:           v1alpha1.ModelSpec{Type: v1alpha1.ModelTypeExternal, External: &v1alpha1.ExternalModelSpec{Endpoint: "http://embedder/v1/models/encoder:predict"}},
+			token:          "key",
+			wantBaseURL:    "http://embedder/v1/models/encoder:predict",
+			wantAuthHeader: "Authorization",
+			wantAuthValue:  "key",
+		},
+		{
+			name: "external openai with an auth header",
+			spec: v1alpha1.ModelSpec{Type: v1alpha1.ModelTypeExternal, External: &v1alpha1.ExternalModelSpec{
+				Endpoint:   "http://embedder/embeddings",
+				Format:     v1alpha1.ExternalModelFormatOpenAI,
+				AuthHeader: "X-API-Key",
+			}},
+			token:          "key",
+			wantBaseURL:    "http://embedder/embeddings",
+			wantAuthHeader: "X-API-Key",
+			wantAuthValue:  "key",
+			wantOpenAI:     true,
+		},
+		{
+			name:    "unsupported external format",
+			spec:    v1alpha1.ModelSpec{Type: v1alpha1.ModelTypeExternal, External: &v1alpha1.ExternalModelSpec{Endpoint: "http://embedder", Format: "GRPC"}},
+			wantErr: true,
+		},
+		{
+			name:    "missing external spec",
+			spec:    v1alpha1.ModelSpec{Type: v1alpha1.ModelTypeExternal},
+			wantErr: true,
+		},
+		{
+			name:    "missing openai spec",
+			spec:    v1alpha1.ModelSpec{Type: v1alpha1.ModelTypeOpenAI},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			if tt.token != "" {
+				builder = builder.WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "model", Namespace: "default"},
+					Data:       map[string][]byte{"token": []byte(tt.token)},
+				})
+			}
+			model := &v1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: "model", Namespace: "default"}, Spec: tt.spec}
+
+			got, err := NewModelClient(context.Background(), builder.Build(), model)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewModelClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.baseURL != tt.wantBaseURL || got.authHeader != tt.wantAuthHeader || got.authValue != tt.wantAuthValue {
+				t.Errorf("NewModelClient() = %s %s: %s, want %s %s: %s",
+					got.baseURL, got.authHeader, got.authValue, tt.wantBaseURL, tt.wantAuthHeader, tt.wantAuthValue)
+			}
+			if (got.openAI != nil) != tt.wantOpenAI {
+				t.Errorf("NewModelClient() openAI = %v, want %v", got.openAI != nil, tt.wantOpenAI)
+			}
+		})
+	}
+}
+
+func TestProbe(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{
+			name:   "embeddings",
+			status: http.StatusOK,
+			body:   `{"results":{"probe":{"embeddings":[{"chunk_id":0,"embedding":[0.1]}]}}}`,
+		},
+		{name: "no embeddings", status: http.StatusOK, body: `{"results":{}}`, wantErr: true},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload struct {
+					Instances []map[string]string `json:"instances"`
+				}
+				if r.Header.Get("X-API-Key") != "key" || json.NewDecoder(r.Body).Decode(&payload) != nil || len(payload.Instances) != 1 {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			ec, err := NewExternalClient(&v1alpha1.ExternalModelSpec{Endpoint: server.URL, AuthHeader: "X-API-Key"}, "key")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := ec.Probe(time.Second); (err != nil) != tt.wantErr {
+				t.Errorf("Probe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// encoderServer returns a server in the format of the encoder model server. Every
+// instance is split into two chunks whose embeddings are the length of the code
+// and its double.
+func encoderServer(t *testing.T, requests *int) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Instances []map[string]string `json:"instances"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		*requests++
+		result := CodeEmbeddingsResponse{Results: make(map[string]CodeEmbeddings)}
+		for _, instance := range payload.Instances {
+			length := float32(len(instance["code"]))
+			result.Results[instance["file_path"]] = CodeEmbeddings{Embeddings: []CodeEmbeddingChunk{
+				{ChunkID: 0, Embedding: []float32{length}},
+				{ChunkID: 1, Embedding: []float32{2 * length}},
+			}}
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestEmbedTexts(t *testing.T) {
+	var requests int
+	ec := NewClient("model", "default")
+	ec.baseURL = encoderServer(t, &requests).URL
+
+	texts := make([]string, textBatchSize+1)
+	for i := range texts {
+		texts[i] = strconv.Itoa(i)
+	}
+	got, err := ec.EmbedTexts(texts)
+	if err != nil {
+		t.Fatalf("EmbedTexts() error = %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("EmbedTexts() sent %d requests, want 2", requests)
+	}
+	// The embeddings of the chunks of a text are averaged.
+	for i, embedding := range got {
+		if want := 1.5 * float32(len(texts[i])); len(embedding) != 1 || embedding[0] != want {
+			t.Errorf("EmbedTexts() embedding %d = %v, want [%v]", i, embedding, want)
+		}
+	}
+}
+
+func TestFetchChunkEmbeddings(t *testing.T) {
+	var requests int
+	ec := NewClient("model", "default")
+	ec.baseURL = encoderServer(t, &requests).URL
+
+	c, err := chunker.New(chunker.StrategyLines, 2, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := []CodeEmbeddingRequest{
+		{Path: "a.go", Hash: "a", Content: "package a\n\nfunc A() {}\n"},
+		{Path: "b.go", Hash: "b", Content: "package b\n"},
+	}
+	got, err := ec.FetchChunkEmbeddings(files, c)
+	if err != nil {
+		t.Fatalf("FetchChunkEmbeddings() error = %v", err)
+	}
+
+	want := map[string][]CodeEmbeddingChunk{
+		"a.go": {
+			{ChunkID: 0, FileHash: "a", Code: "package a\n\n", StartIndex: 0, EndIndex: 11, Embedding: []float32{16.5}},
+			{ChunkID: 1, FileHash: "a", Code: "\nfunc A() {}\n", StartIndex: 10, EndIndex: 23, Embedding: []float32{19.5}},
+		},
+		"b.go": {
+			{ChunkID: 0, FileHash: "b", Code: "package b\n", StartIndex: 0, EndIndex: 10, Embedding: []float32{15}},
+		},
+	}
+	for path, chunks := range want {
+		if !reflect.DeepEqual(got.Results[path].Embeddings, chunks) {
+			t.Errorf("FetchChunkEmbeddings() %s = %+v, want %+v", path, got.Results[path].Embeddings, chunks)
+		}
+	}
+}
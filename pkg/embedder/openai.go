@@ -0,0 +1,105 @@
+package embedder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/encoder-run/operator/pkg/chunker"
+)
+
+const (
+	// DefaultOpenAIBaseURL is the base URL of the OpenAI API.
+	DefaultOpenAIBaseURL = "https://api.openai.com/v1"
+	// openAIBatchSize is the maximum number of inputs sent in a single request.
+	openAIBatchSize = 100
+)
+
+type openAIConfig struct {
+	model string
+}
+
+type openAIEmbeddingsRequest struct {
+	Model string   `json:"model,omitempty"`
+	Input []string `json:"input"`
+}
+
+type openAIEmbeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+// NewOpenAIClient creates a new client for fetching embeddings from an OpenAI
+// compatible /v1/embeddings API. The token is sent as a bearer token if set.
+func NewOpenAIClient(baseURL, model, token string) *EmbeddingClient {
+	if baseURL == "" {
+		baseURL = DefaultOpenAIBaseURL
+	}
+	ec := &EmbeddingClient{
+		httpClient: &http.Client{},
+		baseURL:    strings.TrimSuffix(baseURL, "/") + "/embeddings",
+		openAI:     &openAIConfig{model: model},
+	}
+	if token != "" {
+		ec.authHeader = "Authorization"
+		ec.authValue = "Bearer " + token
+	}
+	return ec
+}
+
+// fetchOpenAIEmbeddings splits the files into chunks with the default chunker since
+// OpenAI compatible APIs embed whole inputs.
+func (ec *EmbeddingClient) fetchOpenAIEmbeddings(requests []CodeEmbeddingRequest) (*CodeEmbeddingsResponse, error) {
+	return ec.FetchChunkEmbeddings(requests, chunker.Default())
+}
+
+// embedOpenAITexts returns the embeddings of the texts in order, in batches.
+func (ec *EmbeddingClient) embedOpenAITexts(texts []string) ([][]float32, error) {
+	embeddings := make([][]float32, 0, len(texts))
+	for start := 0; start < len(texts); start += openAIBatchSize {
+		end := start + openAIBatchSize
+		if end > len(texts) {
+			end = len(texts)
+		}
+		batch, err := ec.createOpenAIEmbeddings(texts[start:end])
+		if err != nil {
+			return nil, err
+		}
+		embeddings = append(embeddings, batch...)
+	}
+	return embeddings, nil
+}
+
+// createOpenAIEmbeddings returns the embeddings of the inputs in order.
+func (ec *EmbeddingClient) createOpenAIEmbeddings(inputs []string) ([][]float32, error) {
+	payload, err := json.Marshal(openAIEmbeddingsRequest{Model: ec.openAI.model, Input: inputs})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ec.post(payload)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var result openAIEmbeddingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	if len(result.Data) != len(inputs) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(inputs), len(result.Data))
+	}
+
+	embeddings := make([][]float32, len(inputs))
+	for _, d := range result.Data {
+		if d.Index < 0 || d.Index >= len(inputs) {
+			return nil, fmt.Errorf("embedding index %d out of range", d.Index)
+		}
+		embeddings[d.Index] = d.Embedding
+	}
+	return embeddings, nil
+}
@@ -0,0 +1,84 @@
+package embedder
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestEmbedOpenAITexts(t *testing.T) {
+	var requests []openAIEmbeddingsRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/embeddings" || r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req openAIEmbeddingsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		requests = append(requests, req)
+
+		// Answer in reverse order, the embedding is the length of the input.
+		var resp openAIEmbeddingsResponse
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			resp.Data = append(resp.Data, struct {
+				Index     int       `json:"index"`
+				Embedding []float32 `json:"embedding"`
+			}{Index: i, Embedding: []float32{float32(len(req.Input[i]))}})
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	texts := make([]string, openAIBatchSize+1)
+	for i := range texts {
+		texts[i] = strings.Repeat("x", i+1)
+	}
+	ec := NewOpenAIClient(server.URL+"/v1/", "text-embedding-3-small", "token")
+	got, err := ec.EmbedTexts(texts)
+	if err != nil {
+		t.Fatalf("EmbedTexts() error = %v", err)
+	}
+
+	if len(requests) != 2 || len(requests[0].Input) != openAIBatchSize || len(requests[1].Input) != 1 {
+		t.Fatalf("EmbedTexts() sent %d requests, want batches of %d and 1 inputs", len(requests), openAIBatchSize)
+	}
+	if requests[0].Model != "text-embedding-3-small" {
+		t.Errorf("EmbedTexts() model = %q, want text-embedding-3-small", requests[0].Model)
+	}
+	for i, embedding := range got {
+		if len(embedding) != 1 || embedding[0] != float32(i+1) {
+			t.Fatalf("EmbedTexts() embedding %d = %v, want the embedding of text %d", i, embedding, i)
+		}
+	}
+}
+
+func TestFetchOpenAIEmbeddingsErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{name: "failed request", status: http.StatusTooManyRequests, body: `{"error":"rate limited"}`},
+		{name: "missing embeddings", status: http.StatusOK, body: `{"data":[]}`},
+		{name: "index out of range", status: http.StatusOK, body: `{"data":[{"index":1,"embedding":[1]}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			ec := NewOpenAIClient(server.URL, "model", "")
+			if _, err := ec.FetchEmbeddings([]CodeEmbeddingRequest{{Path: "main.go", Content: "package main\n"}}); err == nil {
+				t.Error("FetchEmbeddings() succeeded, want an error")
+			}
+		})
+	}
+}
@@ -2,8 +2,10 @@ package converters
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/embedder"
 	"github.com/encoder-run/operator/pkg/graph/model"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -16,6 +18,8 @@ func ModelCRDToModel(modelCRD *v1alpha1.Model) (*model.Model, error) {
 	switch modelCRD.Spec.Type {
 	case v1alpha1.ModelTypeHuggingFace:
 		modelType = model.ModelTypeHuggingface
+	case v1alpha1.ModelTypeOpenAI:
+		modelType = model.ModelTypeOpenai
 	case v1alpha1.ModelTypeExternal:
 		modelType = model.ModelTypeExternal
 	default:
@@ -31,6 +35,36 @@ func ModelCRDToModel(modelCRD *v1alpha1.Model) (*model.Model, error) {
 		}
 		m.DisplayName = fmt.Sprintf("%s/%s", modelCRD.Spec.HuggingFace.Organization, modelCRD.Spec.HuggingFace.Name)
 	}
+	if modelCRD.Spec.Type == v1alpha1.ModelTypeOpenAI && modelCRD.Spec.OpenAI != nil {
+		baseURL := modelCRD.Spec.OpenAI.BaseURL
+		if baseURL == "" {
+			baseURL = embedder.DefaultOpenAIBaseURL
+		}
+		m.OpenAi = &model.OpenAi{
+			BaseURL: baseURL,
+			Model:   modelCRD.Spec.OpenAI.Model,
+		}
+		m.DisplayName = modelCRD.Spec.OpenAI.Model
+	}
+	if modelCRD.Spec.Type == v1alpha1.ModelTypeExternal && modelCRD.Spec.External != nil {
+		format := model.ExternalModelFormatEncoder
+		if modelCRD.Spec.External.Format == v1alpha1.ExternalModelFormatOpenAI {
+			format = model.ExternalModelFormatOpenai
+		}
+		authHeader := modelCRD.Spec.External.AuthHeader
+		if authHeader == "" {
+			authHeader = "Authorization"
+		}
+		m.External = &model.External{
+			Endpoint:   modelCRD.Spec.External.Endpoint,
+			Format:     format,
+			AuthHeader: authHeader,
+		}
+		if modelCRD.Spec.External.Model != "" {
+			m.External.Model = &modelCRD.Spec.External.Model
+		}
+		m.DisplayName = modelCRD.Spec.External.Endpoint
+	}
 
 	if modelCRD.Spec.Deployment != nil {
 		m.Deployment = &model.ModelDeployment{
@@ -40,6 +74,8 @@ func ModelCRDToModel(modelCRD *v1alpha1.Model) (*model.Model, error) {
 		}
 	}
 
+	m.Dimension = modelCRD.Spec.EmbeddingDimension()
+
 	var status model.ModelStatus
 	if modelCRD.Status.State != nil {
 		switch *modelCRD.Status.State {
@@ -79,11 +115,50 @@ func ModelInputToCRD(input model.AddModelInput) (*v1alpha1.Model, error) {
 			Organization:      input.HuggingFace.Organization,
 			MaxSequenceLength: input.HuggingFace.MaxSequenceLength,
 		}
+	case model.ModelTypeOpenai:
+		if input.OpenAi == nil || input.OpenAi.Model == "" {
+			return nil, fmt.Errorf("model cannot be empty")
+		}
+		modelCRD.Spec.Type = v1alpha1.ModelTypeOpenAI
+		modelCRD.Spec.OpenAI = &v1alpha1.OpenAIModelSpec{
+			BaseURL: embedder.DefaultOpenAIBaseURL,
+			Model:   input.OpenAi.Model,
+		}
+		if input.OpenAi.BaseURL != nil && *input.OpenAi.BaseURL != "" {
+			modelCRD.Spec.OpenAI.BaseURL = *input.OpenAi.BaseURL
+		}
 	case model.ModelTypeExternal:
+		if input.External == nil || input.External.Endpoint == "" {
+			return nil, fmt.Errorf("endpoint cannot be empty")
+		}
+		if _, err := url.ParseRequestURI(input.External.Endpoint); err != nil {
+			return nil, fmt.Errorf("invalid endpoint: %w", err)
+		}
 		modelCRD.Spec.Type = v1alpha1.ModelTypeExternal
+		modelCRD.Spec.External = &v1alpha1.ExternalModelSpec{
+			Endpoint:   input.External.Endpoint,
+			Format:     v1alpha1.ExternalModelFormatEncoder,
+			AuthHeader: "Authorization",
+		}
+		if input.External.Format != nil && *input.External.Format == model.ExternalModelFormatOpenai {
+			modelCRD.Spec.External.Format = v1alpha1.ExternalModelFormatOpenAI
+		}
+		if input.External.Model != nil {
+			modelCRD.Spec.External.Model = *input.External.Model
+		}
+		if input.External.AuthHeader != nil && *input.External.AuthHeader != "" {
+			modelCRD.Spec.External.AuthHeader = *input.External.AuthHeader
+		}
 	default:
 		return nil, fmt.Errorf("unsupported model type: %s", input.Type)
 	}
 
+	if input.Dimension != nil {
+		if *input.Dimension < 1 {
+			return nil, fmt.Errorf("invalid embedding dimension: %d", *input.Dimension)
+		}
+		modelCRD.Spec.Dimension = *input.Dimension
+	}
+
 	return modelCRD, nil
 }
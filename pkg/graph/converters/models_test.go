@@ -0,0 +1,117 @@
+package converters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/embedder"
+	"github.com/encoder-run/operator/pkg/graph/model"
+)
+
+func TestModelInputToCRD(t *testing.T) {
+	openAIFormat := model.ExternalModelFormatOpenai
+	modelName := "nomic-embed-text"
+	authHeader := "X-API-Key"
+	dimension := 1536
+	invalidDimension := 0
+
+	tests := []struct {
+		name    string
+		input   model.AddModelInput
+		want    v1alpha1.ModelSpec
+		wantErr bool
+	}{
+		{
+			name:  "openai",
+			input: model.AddModelInput{Type: model.ModelTypeOpenai, OpenAi: &model.OpenAIInput{Model: "text-embedding-3-small"}, Dimension: &dimension},
+			want: v1alpha1.ModelSpec{
+				Type:      v1alpha1.ModelTypeOpenAI,
+				OpenAI:    &v1alpha1.OpenAIModelSpec{BaseURL: embedder.DefaultOpenAIBaseURL, Model: "text-embedding-3-small"},
+				Dimension: 1536,
+			},
+		},
+		{
+			name:    "openai without a model",
+			input:   model.AddModelInput{Type: model.ModelTypeOpenai, OpenAi: &model.OpenAIInput{}},
+			wantErr: true,
+		},
+		{
+			name:  "external encoder",
+			input: model.AddModelInput{Type: model.ModelTypeExternal, External: &model.ExternalInput{Endpoint: "http://embedder:8080/predict"}},
+			want: v1alpha1.ModelSpec{
+				Type: v1alpha1.ModelTypeExternal,
+				External: &v1alpha1.ExternalModelSpec{
+					Endpoint:   "http://embedder:8080/predict",
+					Format:     v1alpha1.ExternalModelFormatEncoder,
+					AuthHeader: "Authorization",
+				},
+			},
+		},
+		{
+			name: "external openai",
+			input: model.AddModelInput{Type: model.ModelTypeExternal, External: &model.ExternalInput{
+				Endpoint:   "http://ollama:11434/v1/embeddings",
+				Format:     &openAIFormat,
+				Model:      &modelName,
+				AuthHeader: &authHeader,
+			}},
+			want: v1alpha1.ModelSpec{
+				Type: v1alpha1.ModelTypeExternal,
+				External: &v1alpha1.ExternalModelSpec{
+					Endpoint:   "http://ollama:11434/v1/embeddings",
+					Format:     v1alpha1.ExternalModelFormatOpenAI,
+					Model:      "nomic-embed-text",
+					AuthHeader: "X-API-Key",
+				},
+			},
+		},
+		{
+			name:    "external without an endpoint",
+			input:   model.AddModelInput{Type: model.ModelTypeExternal, External: &model.ExternalInput{}},
+			wantErr: true,
+		},
+		{
+			name:    "external with an invalid endpoint",
+			input:   model.AddModelInput{Type: model.ModelTypeExternal, External: &model.ExternalInput{Endpoint: "embedder"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid dimension",
+			input:   model.AddModelInput{Type: model.ModelTypeExternal, External: &model.ExternalInput{Endpoint: "http://embedder"}, Dimension: &invalidDimension},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ModelInputToCRD(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ModelInputToCRD() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Spec, tt.want) {
+				t.Errorf("ModelInputToCRD() = %+v, want %+v", got.Spec, tt.want)
+			}
+		})
+	}
+}
+
+func TestExternalModelCRDToModel(t *testing.T) {
+	modelCRD := &v1alpha1.Model{Spec: v1alpha1.ModelSpec{
+		Type:     v1alpha1.ModelTypeExternal,
+		External: &v1alpha1.ExternalModelSpec{Endpoint: "http://embedder/embeddings", Format: v1alpha1.ExternalModelFormatOpenAI},
+	}}
+	got, err := ModelCRDToModel(modelCRD)
+	if err != nil {
+		t.Fatalf("ModelCRDToModel() error = %v", err)
+	}
+	want := &model.External{Endpoint: "http://embedder/embeddings", Format: model.ExternalModelFormatOpenai, AuthHeader: "Authorization"}
+	if !reflect.DeepEqual(got.External, want) {
+		t.Errorf("ModelCRDToModel() external = %+v, want %+v", got.External, want)
+	}
+	if got.DisplayName != "http://embedder/embeddings" || got.Status != model.ModelStatusNotDeployed {
+		t.Errorf("ModelCRDToModel() = %s %s, want the endpoint and NOT_DEPLOYED", got.DisplayName, got.Status)
+	}
+}
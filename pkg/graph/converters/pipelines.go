@@ -2,9 +2,12 @@ package converters
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/chunker"
 	"github.com/encoder-run/operator/pkg/graph/model"
+	"github.com/encoder-run/operator/pkg/schedule"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -35,15 +38,135 @@ func PipelineInputToCRD(input model.AddPipelineInput) (*v1alpha1.Pipeline, error
 				Namespace: "default",
 			},
 		}
+		if input.RepositoryEmbeddings.Chunking != nil {
+			chunking, err := chunkingInputToSpec(input.RepositoryEmbeddings.Chunking)
+			if err != nil {
+				return nil, err
+			}
+			pipelineCRD.Spec.RepositoryEmbeddings.Chunking = chunking
+		}
+		if input.RepositoryEmbeddings.Snapshots != nil {
+			if *input.RepositoryEmbeddings.Snapshots < 0 {
+				return nil, fmt.Errorf("snapshots must not be negative")
+			}
+			pipelineCRD.Spec.RepositoryEmbeddings.Snapshots = *input.RepositoryEmbeddings.Snapshots
+		}
+		if input.RepositoryEmbeddings.Shards != nil {
+			if *input.RepositoryEmbeddings.Shards < 1 || *input.RepositoryEmbeddings.Shards > 32 {
+				return nil, fmt.Errorf("shards must be between 1 and 32")
+			}
+			pipelineCRD.Spec.RepositoryEmbeddings.Shards = *input.RepositoryEmbeddings.Shards
+		}
 	default:
 		return nil, fmt.Errorf("unsupported model type: %s", input.Type)
 	}
 
+	pipelineCRD.Spec.ConcurrencyPolicy = v1alpha1.ConcurrencyPolicyAllow
+	if input.ConcurrencyPolicy != nil {
+		policy, err := ConcurrencyPolicyToCRD(*input.ConcurrencyPolicy)
+		if err != nil {
+			return nil, err
+		}
+		pipelineCRD.Spec.ConcurrencyPolicy = policy
+	}
+
+	if input.RetryPolicy != nil {
+		policy, err := RetryPolicyInputToSpec(input.RetryPolicy)
+		if err != nil {
+			return nil, err
+		}
+		pipelineCRD.Spec.RetryPolicy = policy
+	}
+
+	if input.ExecutionHistory != nil {
+		history, err := ExecutionHistoryInputToSpec(input.ExecutionHistory)
+		if err != nil {
+			return nil, err
+		}
+		pipelineCRD.Spec.ExecutionHistory = history
+	}
+
+	if input.Schedule != nil {
+		schedule, err := ScheduleInputToSpec(input.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		pipelineCRD.Spec.Schedule = schedule
+	}
+
 	pipelineCRD.Spec.Name = input.Name
 	pipelineCRD.Spec.Enabled = false
 	return pipelineCRD, nil
 }
 
+func ConcurrencyPolicyToCRD(policy model.ConcurrencyPolicy) (v1alpha1.ConcurrencyPolicy, error) {
+	switch policy {
+	case model.ConcurrencyPolicyAllow:
+		return v1alpha1.ConcurrencyPolicyAllow, nil
+	case model.ConcurrencyPolicyForbid:
+		return v1alpha1.ConcurrencyPolicyForbid, nil
+	case model.ConcurrencyPolicyReplace:
+		return v1alpha1.ConcurrencyPolicyReplace, nil
+	case model.ConcurrencyPolicyQueue:
+		return v1alpha1.ConcurrencyPolicyQueue, nil
+	default:
+		return "", fmt.Errorf("unsupported concurrency policy: %s", policy)
+	}
+}
+
+func ScheduleInputToSpec(input *model.ScheduleInput) (*v1alpha1.ScheduleSpec, error) {
+	spec := &v1alpha1.ScheduleSpec{
+		Cron:                    input.Cron,
+		TimeZone:                input.TimeZone,
+		StartingDeadlineSeconds: int64Ptr(input.StartingDeadlineSeconds),
+	}
+	if spec.StartingDeadlineSeconds != nil && *spec.StartingDeadlineSeconds < 0 {
+		return nil, fmt.Errorf("startingDeadlineSeconds must not be negative")
+	}
+
+	// Validate the schedule with the parser the controller will use.
+	if _, err := schedule.Parse(spec); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+func int64Ptr(v *int) *int64 {
+	if v == nil {
+		return nil
+	}
+	i := int64(*v)
+	return &i
+}
+
+func chunkingInputToSpec(input *model.ChunkingInput) (*v1alpha1.ChunkingSpec, error) {
+	chunking := &v1alpha1.ChunkingSpec{}
+	switch input.Strategy {
+	case model.ChunkingStrategyTokens:
+		chunking.Strategy = v1alpha1.ChunkingStrategyTokens
+	case model.ChunkingStrategyLines:
+		chunking.Strategy = v1alpha1.ChunkingStrategyLines
+	case model.ChunkingStrategyLanguage:
+		chunking.Strategy = v1alpha1.ChunkingStrategyLanguage
+	case model.ChunkingStrategySyntax:
+		chunking.Strategy = v1alpha1.ChunkingStrategySyntax
+	default:
+		return nil, fmt.Errorf("unsupported chunking strategy: %s", input.Strategy)
+	}
+	if input.Size != nil {
+		chunking.Size = *input.Size
+	}
+	if input.Overlap != nil {
+		chunking.Overlap = *input.Overlap
+	}
+
+	// Validate the size and overlap with the chunker the repository embedder will use.
+	if _, err := chunker.New(chunker.Strategy(chunking.Strategy), chunking.Size, chunking.Overlap); err != nil {
+		return nil, err
+	}
+	return chunking, nil
+}
+
 func PipelineCRDToModel(pipelineCRD *v1alpha1.Pipeline) (*model.Pipeline, error) {
 	p := &model.Pipeline{}
 	p.ID = pipelineCRD.Name
@@ -64,6 +187,16 @@ func PipelineCRDToModel(pipelineCRD *v1alpha1.Pipeline) (*model.Pipeline, error)
 			RepositoryID: pipelineCRD.Spec.RepositoryEmbeddings.Repository.Name,
 			StorageID:    pipelineCRD.Spec.RepositoryEmbeddings.Storage.Name,
 			ModelID:      pipelineCRD.Spec.RepositoryEmbeddings.Model.Name,
+			Dimension:    pipelineCRD.Spec.RepositoryEmbeddings.EmbeddingDimension(),
+			Snapshots:    pipelineCRD.Spec.RepositoryEmbeddings.Snapshots,
+			Shards:       pipelineCRD.Spec.RepositoryEmbeddings.ShardCount(),
+		}
+		if chunking := pipelineCRD.Spec.RepositoryEmbeddings.Chunking; chunking != nil {
+			p.RepositoryEmbeddings.Chunking = &model.Chunking{
+				Strategy: model.ChunkingStrategy(chunking.Strategy),
+				Size:     chunking.Size,
+				Overlap:  chunking.Overlap,
+			}
 		}
 	}
 
@@ -85,27 +218,305 @@ func PipelineCRDToModel(pipelineCRD *v1alpha1.Pipeline) (*model.Pipeline, error)
 	p.Status = status
 
 	p.Enabled = pipelineCRD.Spec.Enabled
+	switch pipelineCRD.Spec.ConcurrencyPolicy {
+	case v1alpha1.ConcurrencyPolicyForbid:
+		p.ConcurrencyPolicy = model.ConcurrencyPolicyForbid
+	case v1alpha1.ConcurrencyPolicyReplace:
+		p.ConcurrencyPolicy = model.ConcurrencyPolicyReplace
+	case v1alpha1.ConcurrencyPolicyQueue:
+		p.ConcurrencyPolicy = model.ConcurrencyPolicyQueue
+	default:
+		p.ConcurrencyPolicy = model.ConcurrencyPolicyAllow
+	}
+	if s := pipelineCRD.Spec.Schedule; s != nil {
+		p.Schedule = scheduleSpecToModel(s)
+	}
+	p.LastScheduleTime = formatTime(pipelineCRD.Status.LastScheduleTime)
+	retryPolicy, err := retryPolicyToModel(pipelineCRD.Spec.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+	p.RetryPolicy = retryPolicy
+	p.ExecutionHistory = executionHistoryToModel(pipelineCRD.Spec.ExecutionHistory)
+	p.Snapshots = indexedCommitsToModel(pipelineCRD.Status.Snapshots)
 	return p, nil
 }
 
+func scheduleSpecToModel(spec *v1alpha1.ScheduleSpec) *model.Schedule {
+	s := &model.Schedule{
+		Cron:     spec.Cron,
+		TimeZone: spec.TimeZone,
+	}
+	if spec.StartingDeadlineSeconds != nil {
+		deadline := int(*spec.StartingDeadlineSeconds)
+		s.StartingDeadlineSeconds = &deadline
+	}
+	return s
+}
+
+func ExecutionHistoryInputToSpec(input *model.ExecutionHistoryInput) (*v1alpha1.ExecutionHistory, error) {
+	history := &v1alpha1.ExecutionHistory{}
+	for _, field := range []struct {
+		value *int
+		field **int32
+	}{
+		{input.SuccessfulExecutionsLimit, &history.SuccessfulExecutionsLimit},
+		{input.FailedExecutionsLimit, &history.FailedExecutionsLimit},
+		{input.TTLSecondsAfterFinished, &history.TTLSecondsAfterFinished},
+	} {
+		if field.value == nil {
+			continue
+		}
+		if *field.value < 0 {
+			return nil, fmt.Errorf("history limits and the TTL must not be negative")
+		}
+		v := int32(*field.value)
+		*field.field = &v
+	}
+	return history, nil
+}
+
+func executionHistoryToModel(history *v1alpha1.ExecutionHistory) *model.ExecutionHistory {
+	h := &model.ExecutionHistory{}
+	if limit, ok := history.SuccessfulLimit(); ok {
+		successful := int(limit)
+		h.SuccessfulExecutionsLimit = &successful
+	}
+	if limit, ok := history.FailedLimit(); ok {
+		failed := int(limit)
+		h.FailedExecutionsLimit = &failed
+	}
+	if ttl, ok := history.TTL(); ok {
+		seconds := int(ttl.Seconds())
+		h.TTLSecondsAfterFinished = &seconds
+	}
+	return h
+}
+
 func PipelineExecutionCRDToModel(pipelineExecutionCRD *v1alpha1.PipelineExecution) (*model.PipelineExecution, error) {
 	p := &model.PipelineExecution{}
 	p.ID = pipelineExecutionCRD.Name
-	var status model.PipelineExecutionStatus
+	status := model.PipelineExecutionStatusPending
 	if pipelineExecutionCRD.Status.State != nil {
-		switch *pipelineExecutionCRD.Status.State {
-		case v1alpha1.PipelineExecutionStateActive:
-			status = model.PipelineExecutionStatusActive
-		case v1alpha1.PipelineExecutionStateSucceeded:
-			status = model.PipelineExecutionStatusSucceeded
-		case v1alpha1.PipelineExecutionStateFailed:
-			status = model.PipelineExecutionStatusFailed
-		default:
-			return nil, fmt.Errorf("unknown pipeline execution state: %s", *pipelineExecutionCRD.Status.State)
+		var err error
+		if status, err = executionStateToModel(*pipelineExecutionCRD.Status.State); err != nil {
+			return nil, err
 		}
-	} else {
-		status = model.PipelineExecutionStatusPending
 	}
 	p.Status = status
+	p.Commits = indexedCommitsToModel(pipelineExecutionCRD.Status.Commits)
+
+	p.Attempts = make([]*model.ExecutionAttempt, 0, len(pipelineExecutionCRD.Status.Attempts))
+	for _, a := range pipelineExecutionCRD.Status.Attempts {
+		attempt := &model.ExecutionAttempt{
+			Attempt:        int(a.Attempt),
+			StartTime:      a.StartTime.Format(time.RFC3339),
+			CompletionTime: formatTime(a.CompletionTime),
+		}
+		var err error
+		if attempt.Status, err = executionStateToModel(a.State); err != nil {
+			return nil, err
+		}
+		if a.Reason != "" {
+			reason, err := failureReasonToModel(a.Reason)
+			if err != nil {
+				return nil, err
+			}
+			attempt.Reason = &reason
+		}
+		if a.Message != "" {
+			message := a.Message
+			attempt.Message = &message
+		}
+		p.Attempts = append(p.Attempts, attempt)
+	}
+	p.NextAttemptTime = formatTime(pipelineExecutionCRD.Status.NextAttemptTime)
+
+	p.StartTime = formatTime(pipelineExecutionCRD.Status.StartTime)
+	p.CompletionTime = formatTime(pipelineExecutionCRD.Status.CompletionTime)
+	if start := pipelineExecutionCRD.Status.StartTime; start != nil {
+		end := time.Now()
+		if completion := pipelineExecutionCRD.Status.CompletionTime; completion != nil {
+			end = completion.Time
+		}
+		duration := int(end.Sub(start.Time).Seconds())
+		p.DurationSeconds = &duration
+	}
+	if reason := pipelineExecutionCRD.Status.Reason; reason != "" {
+		r, err := failureReasonToModel(reason)
+		if err != nil {
+			return nil, err
+		}
+		p.Reason = &r
+	}
+	if message := pipelineExecutionCRD.Status.Message; message != "" {
+		p.Message = &message
+	}
+	if stats := pipelineExecutionCRD.Status.Stats; stats != nil {
+		p.Stats = &model.ExecutionStats{
+			FilesScanned:  int(stats.FilesScanned),
+			FilesSkipped:  int(stats.FilesSkipped),
+			FilesEmbedded: int(stats.FilesEmbedded),
+			FilesDeleted:  int(stats.FilesDeleted),
+			ChunksWritten: int(stats.ChunksWritten),
+		}
+	}
+	progress := pipelineExecutionCRD.Status.Progress
+	if progress == nil && len(pipelineExecutionCRD.Status.ShardProgress) > 0 {
+		progress = shardProgress(pipelineExecutionCRD.Status.ShardProgress)
+	}
+	if progress != nil {
+		p.Progress = &model.ExecutionProgress{
+			FilesProcessed:          int(progress.FilesProcessed),
+			FilesTotal:              int(progress.FilesTotal),
+			Batch:                   int(progress.Batch),
+			EstimatedCompletionTime: formatTime(progress.EstimatedCompletionTime),
+			UpdateTime:              *formatTime(&progress.UpdateTime),
+		}
+	}
+	return p, nil
+}
+
+// shardProgress sums the progress of the shards of an execution, it completes with
+// the slowest shard.
+func shardProgress(shards map[string]v1alpha1.ExecutionProgress) *v1alpha1.ExecutionProgress {
+	progress := &v1alpha1.ExecutionProgress{}
+	for _, shard := range shards {
+		progress.FilesProcessed += shard.FilesProcessed
+		progress.FilesTotal += shard.FilesTotal
+		progress.Batch += shard.Batch
+		if progress.UpdateTime.Before(&shard.UpdateTime) {
+			progress.UpdateTime = shard.UpdateTime
+		}
+		if eta := shard.EstimatedCompletionTime; eta != nil && (progress.EstimatedCompletionTime == nil || progress.EstimatedCompletionTime.Before(eta)) {
+			progress.EstimatedCompletionTime = eta
+		}
+	}
+	return progress
+}
+
+func executionStateToModel(state v1alpha1.PipelineExecutionState) (model.PipelineExecutionStatus, error) {
+	switch state {
+	case v1alpha1.PipelineExecutionStateActive:
+		return model.PipelineExecutionStatusActive, nil
+	case v1alpha1.PipelineExecutionStateSucceeded:
+		return model.PipelineExecutionStatusSucceeded, nil
+	case v1alpha1.PipelineExecutionStateFailed:
+		return model.PipelineExecutionStatusFailed, nil
+	case v1alpha1.PipelineExecutionStatePending:
+		return model.PipelineExecutionStatusPending, nil
+	case v1alpha1.PipelineExecutionStateSkipped:
+		return model.PipelineExecutionStatusSkipped, nil
+	case v1alpha1.PipelineExecutionStateCancelled:
+		return model.PipelineExecutionStatusCancelled, nil
+	default:
+		return "", fmt.Errorf("unknown pipeline execution state: %s", state)
+	}
+}
+
+func failureReasonToModel(reason v1alpha1.FailureReason) (model.FailureReason, error) {
+	switch reason {
+	case v1alpha1.FailureReasonError:
+		return model.FailureReasonError, nil
+	case v1alpha1.FailureReasonOOMKilled:
+		return model.FailureReasonOomKilled, nil
+	case v1alpha1.FailureReasonEvicted:
+		return model.FailureReasonEvicted, nil
+	case v1alpha1.FailureReasonDeadlineExceeded:
+		return model.FailureReasonDeadlineExceeded, nil
+	default:
+		return "", fmt.Errorf("unknown failure reason: %s", reason)
+	}
+}
+
+func failureReasonToCRD(reason model.FailureReason) (v1alpha1.FailureReason, error) {
+	switch reason {
+	case model.FailureReasonError:
+		return v1alpha1.FailureReasonError, nil
+	case model.FailureReasonOomKilled:
+		return v1alpha1.FailureReasonOOMKilled, nil
+	case model.FailureReasonEvicted:
+		return v1alpha1.FailureReasonEvicted, nil
+	case model.FailureReasonDeadlineExceeded:
+		return v1alpha1.FailureReasonDeadlineExceeded, nil
+	default:
+		return "", fmt.Errorf("unsupported failure reason: %s", reason)
+	}
+}
+
+func RetryPolicyInputToSpec(input *model.RetryPolicyInput) (*v1alpha1.RetryPolicy, error) {
+	policy := &v1alpha1.RetryPolicy{}
+	for _, field := range []struct {
+		value *int
+		min   int
+		name  string
+		field **int32
+	}{
+		{input.MaxAttempts, 1, "maxAttempts", &policy.MaxAttempts},
+		{input.BackoffSeconds, 0, "backoffSeconds", &policy.BackoffSeconds},
+		{input.MaxBackoffSeconds, 0, "maxBackoffSeconds", &policy.MaxBackoffSeconds},
+	} {
+		if field.value == nil {
+			continue
+		}
+		if *field.value < field.min {
+			return nil, fmt.Errorf("%s must be at least %d", field.name, field.min)
+		}
+		v := int32(*field.value)
+		*field.field = &v
+	}
+	for _, reason := range input.RetryOn {
+		r, err := failureReasonToCRD(reason)
+		if err != nil {
+			return nil, err
+		}
+		policy.RetryOn = append(policy.RetryOn, r)
+	}
+	return policy, nil
+}
+
+func retryPolicyToModel(policy *v1alpha1.RetryPolicy) (*model.RetryPolicy, error) {
+	p := &model.RetryPolicy{
+		MaxAttempts:       int(policy.Attempts()),
+		BackoffSeconds:    v1alpha1.DefaultRetryBackoffSeconds,
+		MaxBackoffSeconds: v1alpha1.DefaultRetryMaxBackoffSeconds,
+		RetryOn:           make([]model.FailureReason, 0),
+	}
+	if policy != nil && policy.BackoffSeconds != nil {
+		p.BackoffSeconds = int(*policy.BackoffSeconds)
+	}
+	if policy != nil && policy.MaxBackoffSeconds != nil {
+		p.MaxBackoffSeconds = int(*policy.MaxBackoffSeconds)
+	}
+	for _, reason := range []v1alpha1.FailureReason{v1alpha1.FailureReasonError, v1alpha1.FailureReasonOOMKilled, v1alpha1.FailureReasonEvicted} {
+		if !policy.Retryable(reason) {
+			continue
+		}
+		r, err := failureReasonToModel(reason)
+		if err != nil {
+			return nil, err
+		}
+		p.RetryOn = append(p.RetryOn, r)
+	}
 	return p, nil
 }
+
+func formatTime(t *v1.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(time.RFC3339)
+	return &s
+}
+
+func indexedCommitsToModel(commits []v1alpha1.IndexedCommit) []*model.IndexedCommit {
+	result := make([]*model.IndexedCommit, 0, len(commits))
+	for _, c := range commits {
+		result = append(result, &model.IndexedCommit{
+			Ref:    c.Ref,
+			Commit: c.Commit,
+			Tree:   c.Tree,
+		})
+	}
+	return result
+}
@@ -0,0 +1,120 @@
+package converters
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/graph/model"
+)
+
+func TestPipelineExecutionCRDToModel(t *testing.T) {
+	start := metav1.NewTime(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC))
+	completion := metav1.NewTime(start.Add(90 * time.Second))
+	failed := v1alpha1.PipelineExecutionStateFailed
+
+	pe := &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "execution"},
+		Status: v1alpha1.PipelineExecutionStatus{
+			State:          &failed,
+			StartTime:      &start,
+			CompletionTime: &completion,
+			Reason:         v1alpha1.FailureReasonOOMKilled,
+			Message:        "out of memory",
+			Stats:          &v1alpha1.ExecutionStats{FilesScanned: 4, FilesSkipped: 1, FilesEmbedded: 3, FilesDeleted: 2, ChunksWritten: 7},
+		},
+	}
+	got, err := PipelineExecutionCRDToModel(pe)
+	if err != nil {
+		t.Fatalf("PipelineExecutionCRDToModel() error = %v", err)
+	}
+	if got.Status != model.PipelineExecutionStatusFailed {
+		t.Errorf("PipelineExecutionCRDToModel() status = %s, want %s", got.Status, model.PipelineExecutionStatusFailed)
+	}
+	if got.StartTime == nil || *got.StartTime != "2024-05-01T10:00:00Z" {
+		t.Errorf("PipelineExecutionCRDToModel() startTime = %v, want 2024-05-01T10:00:00Z", got.StartTime)
+	}
+	if got.DurationSeconds == nil || *got.DurationSeconds != 90 {
+		t.Errorf("PipelineExecutionCRDToModel() durationSeconds = %v, want 90", got.DurationSeconds)
+	}
+	if got.Reason == nil || *got.Reason != model.FailureReasonOomKilled {
+		t.Errorf("PipelineExecutionCRDToModel() reason = %v, want %s", got.Reason, model.FailureReasonOomKilled)
+	}
+	if got.Message == nil || *got.Message != "out of memory" {
+		t.Errorf("PipelineExecutionCRDToModel() message = %v, want out of memory", got.Message)
+	}
+	wantStats := &model.ExecutionStats{FilesScanned: 4, FilesSkipped: 1, FilesEmbedded: 3, FilesDeleted: 2, ChunksWritten: 7}
+	if !reflect.DeepEqual(got.Stats, wantStats) {
+		t.Errorf("PipelineExecutionCRDToModel() stats = %+v, want %+v", got.Stats, wantStats)
+	}
+
+	// Executions without a start time have no duration.
+	got, err = PipelineExecutionCRDToModel(&v1alpha1.PipelineExecution{})
+	if err != nil {
+		t.Fatalf("PipelineExecutionCRDToModel() error = %v", err)
+	}
+	if got.Status != model.PipelineExecutionStatusPending || got.DurationSeconds != nil || got.Stats != nil {
+		t.Errorf("PipelineExecutionCRDToModel() = %+v, want a pending execution without duration and stats", got)
+	}
+}
+
+func TestPipelineInputToCRDConcurrencyPolicy(t *testing.T) {
+	forbid := model.ConcurrencyPolicyForbid
+	tests := []struct {
+		name   string
+		policy *model.ConcurrencyPolicy
+		want   v1alpha1.ConcurrencyPolicy
+	}{
+		{name: "default", want: v1alpha1.ConcurrencyPolicyAllow},
+		{name: "policy of the input", policy: &forbid, want: v1alpha1.ConcurrencyPolicyForbid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PipelineInputToCRD(model.AddPipelineInput{
+				Type:                 model.PipelineTypeRepositoryEmbeddings,
+				Name:                 "pipeline",
+				RepositoryEmbeddings: &model.AddRepositoryEmbeddingsInput{RepositoryID: "repository", ModelID: "model", StorageID: "storage"},
+				ConcurrencyPolicy:    tt.policy,
+			})
+			if err != nil {
+				t.Fatalf("PipelineInputToCRD() error = %v", err)
+			}
+			if got.Spec.ConcurrencyPolicy != tt.want {
+				t.Errorf("PipelineInputToCRD() policy = %s, want %s", got.Spec.ConcurrencyPolicy, tt.want)
+			}
+		})
+	}
+}
+
+func TestPipelineCRDToModelConcurrencyPolicy(t *testing.T) {
+	tests := []struct {
+		policy v1alpha1.ConcurrencyPolicy
+		want   model.ConcurrencyPolicy
+	}{
+		// Pipelines created before the policy was set run alongside each other.
+		{policy: "", want: model.ConcurrencyPolicyAllow},
+		{policy: v1alpha1.ConcurrencyPolicyAllow, want: model.ConcurrencyPolicyAllow},
+		{policy: v1alpha1.ConcurrencyPolicyQueue, want: model.ConcurrencyPolicyQueue},
+		{policy: v1alpha1.ConcurrencyPolicyReplace, want: model.ConcurrencyPolicyReplace},
+	}
+	for _, tt := range tests {
+		pipeline := &v1alpha1.Pipeline{
+			ObjectMeta: metav1.ObjectMeta{Name: "pipeline"},
+			Spec: v1alpha1.PipelineSpec{
+				Type:                 v1alpha1.PipelineTypeRepositoryEmbeddings,
+				RepositoryEmbeddings: &v1alpha1.RepositoryEmbeddingsSpec{},
+				ConcurrencyPolicy:    tt.policy,
+			},
+		}
+		got, err := PipelineCRDToModel(pipeline)
+		if err != nil {
+			t.Fatalf("PipelineCRDToModel() error = %v", err)
+		}
+		if got.ConcurrencyPolicy != tt.want {
+			t.Errorf("PipelineCRDToModel() policy of %q = %s, want %s", tt.policy, got.ConcurrencyPolicy, tt.want)
+		}
+	}
+}
@@ -3,12 +3,19 @@ package converters
 import (
 	"errors"
 	"fmt"
+	neturl "net/url"
+	"path"
 	"regexp"
+	"strings"
 
 	"github.com/encoder-run/operator/api/cloud/v1alpha1"
 	"github.com/encoder-run/operator/pkg/graph/model"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 )
 
+// DefaultGitlabBaseURL is the base URL used for Gitlab repositories when none is provided.
+const DefaultGitlabBaseURL = "https://gitlab.com"
+
 func RepositoryCRDToModel(repo *v1alpha1.Repository) (*model.Repository, error) {
 	var repoType model.RepositoryType
 	switch repo.Spec.Type {
@@ -18,51 +25,151 @@ func RepositoryCRDToModel(repo *v1alpha1.Repository) (*model.Repository, error)
 		repoType = model.RepositoryTypeGitlab
 	case v1alpha1.RepositoryTypeBitbucket:
 		repoType = model.RepositoryTypeBitbucket
+	case v1alpha1.RepositoryTypeGit:
+		repoType = model.RepositoryTypeGit
 	default:
 		return nil, fmt.Errorf("unknown repository type: %s", repo.Spec.Type)
 	}
 
-	if repo.Spec.Type == v1alpha1.RepositoryTypeGithub {
-		return &model.Repository{
-			ID:          repo.Name,
-			Name:        repo.Spec.Github.Name,
-			Owner:       repo.Spec.Github.Owner,
-			Type:        repoType,
-			URL:         repo.Spec.Github.URL,
-			DisplayName: fmt.Sprintf("%s/%s", repo.Spec.Github.Owner, repo.Spec.Github.Name),
-		}, nil
+	owner, name, err := repositoryOwnerAndName(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := RepositoryCRDURL(repo)
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, fmt.Errorf("unsupported repository type: %s", repo.Spec.Type)
+	return &model.Repository{
+		ID:          repo.Name,
+		Name:        name,
+		Owner:       owner,
+		Type:        repoType,
+		URL:         url,
+		DisplayName: fmt.Sprintf("%s/%s", owner, name),
+		Refs:        repo.Spec.IndexedRefs(),
+	}, nil
 }
 
-func SplitRepositoryURL(url string) (v1alpha1.RepositoryType, string, string, error) {
-	// Regular expression to match the URL patterns
-	regex := regexp.MustCompile(`(?:https?://)?(?:www\.)?(?P<type>github|gitlab|bitbucket)\.com/(?P<owner>[^/]+)/(?P<name>[^/.]+)(?:\.git)?`)
+// repositoryOwnerAndName returns the owner and name of the repository. For Gitlab
+// projects the owner is the full group path of the project.
+func repositoryOwnerAndName(repo *v1alpha1.Repository) (string, string, error) {
+	switch repo.Spec.Type {
+	case v1alpha1.RepositoryTypeGithub:
+		if repo.Spec.Github == nil {
+			return "", "", fmt.Errorf("github spec is required for repository type: %s", repo.Spec.Type)
+		}
+		return repo.Spec.Github.Owner, repo.Spec.Github.Name, nil
+	case v1alpha1.RepositoryTypeGitlab:
+		if repo.Spec.Gitlab == nil {
+			return "", "", fmt.Errorf("gitlab spec is required for repository type: %s", repo.Spec.Type)
+		}
+		return path.Dir(repo.Spec.Gitlab.ProjectPath), path.Base(repo.Spec.Gitlab.ProjectPath), nil
+	case v1alpha1.RepositoryTypeBitbucket:
+		if repo.Spec.Bitbucket == nil {
+			return "", "", fmt.Errorf("bitbucket spec is required for repository type: %s", repo.Spec.Type)
+		}
+		return repo.Spec.Bitbucket.Workspace, repo.Spec.Bitbucket.Name, nil
+	case v1alpha1.RepositoryTypeGit:
+		if repo.Spec.Git == nil {
+			return "", "", fmt.Errorf("git spec is required for repository type: %s", repo.Spec.Type)
+		}
+		return path.Dir(repo.Spec.Git.URL), path.Base(repo.Spec.Git.URL), nil
+	default:
+		return "", "", fmt.Errorf("unsupported repository type: %s", repo.Spec.Type)
+	}
+}
 
-	matches := regex.FindStringSubmatch(url)
-	if matches == nil {
-		return "", "", "", errors.New("invalid repository URL")
-	}
-
-	// Extracting matches
-	repositoryType := matches[regex.SubexpIndex("type")]
-	var repoType v1alpha1.RepositoryType
-	switch repositoryType {
-	case "github":
-		repoType = v1alpha1.RepositoryTypeGithub
-	case "gitlab":
-		repoType = v1alpha1.RepositoryTypeGitlab
-	case "bitbucket":
-		repoType = v1alpha1.RepositoryTypeBitbucket
+// RepositoryCRDURL returns the URL of the repository without its scheme. The URL
+// is also used as the key prefix for everything stored about the repository.
+func RepositoryCRDURL(repo *v1alpha1.Repository) (string, error) {
+	switch repo.Spec.Type {
+	case v1alpha1.RepositoryTypeGithub:
+		if repo.Spec.Github == nil {
+			return "", fmt.Errorf("github spec is required for repository type: %s", repo.Spec.Type)
+		}
+		return repo.Spec.Github.URL, nil
+	case v1alpha1.RepositoryTypeGitlab:
+		if repo.Spec.Gitlab == nil {
+			return "", fmt.Errorf("gitlab spec is required for repository type: %s", repo.Spec.Type)
+		}
+		return repo.Spec.Gitlab.URL, nil
+	case v1alpha1.RepositoryTypeBitbucket:
+		if repo.Spec.Bitbucket == nil {
+			return "", fmt.Errorf("bitbucket spec is required for repository type: %s", repo.Spec.Type)
+		}
+		return repo.Spec.Bitbucket.URL, nil
+	case v1alpha1.RepositoryTypeGit:
+		if repo.Spec.Git == nil {
+			return "", fmt.Errorf("git spec is required for repository type: %s", repo.Spec.Type)
+		}
+		return repo.Spec.Git.URL, nil
+	default:
+		return "", fmt.Errorf("unsupported repository type: %s", repo.Spec.Type)
+	}
+}
+
+// SplitRepositoryURL splits a repository URL into its type, base URL, owner and name.
+// Gitlab projects can be nested in subgroups so the owner holds the full group path.
+func SplitRepositoryURL(url string) (v1alpha1.RepositoryType, string, string, string, error) {
+	scheme, host, segments, err := splitURL(url)
+	if err != nil {
+		return "", "", "", "", err
+	}
+
+	switch {
+	case host == "github.com":
+		return v1alpha1.RepositoryTypeGithub, fmt.Sprintf("%s://%s", scheme, host), segments[0], segments[1], nil
+	case host == "bitbucket.org":
+		return v1alpha1.RepositoryTypeBitbucket, fmt.Sprintf("%s://%s", scheme, host), segments[0], segments[1], nil
+	case strings.Contains(host, "gitlab"):
+		return SplitGitlabURL(url)
 	default:
-		return "", "", "", fmt.Errorf("unsupported repository type: %s", repositoryType)
+		return "", "", "", "", fmt.Errorf("unsupported repository host: %s", host)
 	}
+}
 
-	owner := matches[regex.SubexpIndex("owner")]
-	name := matches[regex.SubexpIndex("name")]
+// SplitGitlabURL splits the URL of a Gitlab project, which may be hosted on a
+// self-managed instance, into its base URL, group path and project name.
+func SplitGitlabURL(url string) (v1alpha1.RepositoryType, string, string, string, error) {
+	scheme, host, segments, err := splitURL(url)
+	if err != nil {
+		return "", "", "", "", err
+	}
+	// Gitlab separates the project path from the page path with "/-/".
+	for i, s := range segments {
+		if s == "-" {
+			segments = segments[:i]
+			break
+		}
+	}
+	if len(segments) < 2 {
+		return "", "", "", "", errors.New("invalid repository URL")
+	}
+	owner := strings.Join(segments[:len(segments)-1], "/")
+	return v1alpha1.RepositoryTypeGitlab, fmt.Sprintf("%s://%s", scheme, host), owner, segments[len(segments)-1], nil
+}
+
+// splitURL returns the scheme, host and path segments of a repository URL.
+func splitURL(url string) (string, string, []string, error) {
+	// Regular expression to match the URL patterns
+	regex := regexp.MustCompile(`^(?:(?P<scheme>https?)://)?(?:www\.)?(?P<host>[^/]+)/(?P<path>[^?#]+?)(?:\.git)?/?(?:[?#].*)?$`)
+
+	matches := regex.FindStringSubmatch(strings.TrimSpace(url))
+	if matches == nil {
+		return "", "", nil, errors.New("invalid repository URL")
+	}
 
-	return repoType, owner, name, nil
+	scheme := matches[regex.SubexpIndex("scheme")]
+	if scheme == "" {
+		scheme = "https"
+	}
+	segments := strings.Split(matches[regex.SubexpIndex("path")], "/")
+	if len(segments) < 2 {
+		return "", "", nil, errors.New("invalid repository URL")
+	}
+	return scheme, matches[regex.SubexpIndex("host")], segments, nil
 }
 
 func RepositoryURL(repoType model.RepositoryType, owner, name string) string {
@@ -77,3 +184,30 @@ func RepositoryURL(repoType model.RepositoryType, owner, name string) string {
 		return ""
 	}
 }
+
+// GitRepositoryURL builds the repository URL of any git clone URL by dropping the
+// scheme, user, port and .git suffix, e.g. git@host:team/repo.git becomes host/team/repo.
+func GitRepositoryURL(cloneURL string) (string, error) {
+	ep, err := transport.NewEndpoint(strings.TrimSpace(cloneURL))
+	if err != nil {
+		return "", err
+	}
+	url := strings.Trim(path.Join(ep.Host, strings.TrimSuffix(ep.Path, ".git")), "/")
+	if url == "" || path.Base(url) == url {
+		return "", fmt.Errorf("invalid git clone URL: %s", cloneURL)
+	}
+	return url, nil
+}
+
+// GitlabRepositoryURL builds the repository URL of a Gitlab project from the base URL
+// of the instance and the project path.
+func GitlabRepositoryURL(baseURL, projectPath string) (string, error) {
+	u, err := neturl.Parse(baseURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid gitlab base URL: %s", baseURL)
+	}
+	return path.Join(u.Host, u.Path, projectPath), nil
+}
@@ -0,0 +1,268 @@
+package converters
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/graph/model"
+)
+
+func TestSplitRepositoryURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		wantType  v1alpha1.RepositoryType
+		wantBase  string
+		wantOwner string
+		wantName  string
+		wantErr   bool
+	}{
+		{
+			name:      "github",
+			url:       "https://github.com/encoder-run/operator",
+			wantType:  v1alpha1.RepositoryTypeGithub,
+			wantBase:  "https://github.com",
+			wantOwner: "encoder-run",
+			wantName:  "operator",
+		},
+		{
+			name:      "clone URL without a scheme",
+			url:       "github.com/encoder-run/operator.git",
+			wantType:  v1alpha1.RepositoryTypeGithub,
+			wantBase:  "https://github.com",
+			wantOwner: "encoder-run",
+			wantName:  "operator",
+		},
+		{
+			name:      "bitbucket",
+			url:       "https://bitbucket.org/workspace/repository.git",
+			wantType:  v1alpha1.RepositoryTypeBitbucket,
+			wantBase:  "https://bitbucket.org",
+			wantOwner: "workspace",
+			wantName:  "repository",
+		},
+		{
+			name:      "gitlab project",
+			url:       "https://gitlab.com/group/project",
+			wantType:  v1alpha1.RepositoryTypeGitlab,
+			wantBase:  "https://gitlab.com",
+			wantOwner: "group",
+			wantName:  "project",
+		},
+		{
+			name:      "gitlab project in subgroups",
+			url:       "https://gitlab.com/group/sub/project.git",
+			wantType:  v1alpha1.RepositoryTypeGitlab,
+			wantBase:  "https://gitlab.com",
+			wantOwner: "group/sub",
+			wantName:  "project",
+		},
+		{
+			name:      "page of a gitlab project",
+			url:       "https://gitlab.com/group/sub/project/-/tree/main",
+			wantType:  v1alpha1.RepositoryTypeGitlab,
+			wantBase:  "https://gitlab.com",
+			wantOwner: "group/sub",
+			wantName:  "project",
+		},
+		{
+			name:      "self-managed gitlab",
+			url:       "http://gitlab.example.com/team/project",
+			wantType:  v1alpha1.RepositoryTypeGitlab,
+			wantBase:  "http://gitlab.example.com",
+			wantOwner: "team",
+			wantName:  "project",
+		},
+		{
+			name:    "unsupported host",
+			url:     "https://example.com/owner/name",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			url:     "https://github.com/encoder-run",
+			wantErr: true,
+		},
+		{
+			name:    "missing project below the page path",
+			url:     "https://gitlab.com/group/-/tree/main",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoType, base, owner, name, err := SplitRepositoryURL(tt.url)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SplitRepositoryURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if repoType != tt.wantType || base != tt.wantBase || owner != tt.wantOwner || name != tt.wantName {
+				t.Errorf("SplitRepositoryURL() = %s, %s, %s, %s, want %s, %s, %s, %s",
+					repoType, base, owner, name, tt.wantType, tt.wantBase, tt.wantOwner, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestGitlabRepositoryURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		baseURL     string
+		projectPath string
+		want        string
+		wantErr     bool
+	}{
+		{
+			name:        "gitlab.com",
+			baseURL:     DefaultGitlabBaseURL,
+			projectPath: "group/sub/project",
+			want:        "gitlab.com/group/sub/project",
+		},
+		{
+			name:        "instance below a path",
+			baseURL:     "https://example.com/gitlab/",
+			projectPath: "group/project",
+			want:        "example.com/gitlab/group/project",
+		},
+		{
+			name:        "base URL without a scheme",
+			baseURL:     "gitlab.example.com",
+			projectPath: "group/project",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GitlabRepositoryURL(tt.baseURL, tt.projectPath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GitlabRepositoryURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GitlabRepositoryURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGitRepositoryURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		cloneURL string
+		want     string
+		wantErr  bool
+	}{
+		{name: "scp-like", cloneURL: "git@example.com:team/repo.git", want: "example.com/team/repo"},
+		{name: "ssh with a port", cloneURL: "ssh://git@example.com:2222/team/repo.git", want: "example.com/team/repo"},
+		{name: "https with a user", cloneURL: "https://user@example.com/team/sub/repo", want: "example.com/team/sub/repo"},
+		{name: "local repository", cloneURL: "file:///srv/git/repo.git", want: "srv/git/repo"},
+		{name: "host without a path", cloneURL: "https://example.com/", wantErr: true},
+		{name: "single path segment", cloneURL: "repo.git", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GitRepositoryURL(tt.cloneURL)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GitRepositoryURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GitRepositoryURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRepositoryCRDToModel(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    v1alpha1.RepositorySpec
+		want    model.Repository
+		wantErr bool
+	}{
+		{
+			name: "github",
+			spec: v1alpha1.RepositorySpec{
+				Type:   v1alpha1.RepositoryTypeGithub,
+				Github: &v1alpha1.GithubRepositorySpec{Owner: "encoder-run", Name: "operator", Branch: "main", URL: "github.com/encoder-run/operator"},
+			},
+			want: model.Repository{
+				Owner:       "encoder-run",
+				Name:        "operator",
+				Type:        model.RepositoryTypeGithub,
+				URL:         "github.com/encoder-run/operator",
+				DisplayName: "encoder-run/operator",
+				Refs:        []string{"main"},
+			},
+		},
+		{
+			name: "gitlab project in subgroups",
+			spec: v1alpha1.RepositorySpec{
+				Type:   v1alpha1.RepositoryTypeGitlab,
+				Gitlab: &v1alpha1.GitlabRepositorySpec{ProjectPath: "group/sub/project", URL: "gitlab.com/group/sub/project"},
+				Refs:   []string{"main", "refs/tags/v*"},
+			},
+			want: model.Repository{
+				Owner:       "group/sub",
+				Name:        "project",
+				Type:        model.RepositoryTypeGitlab,
+				URL:         "gitlab.com/group/sub/project",
+				DisplayName: "group/sub/project",
+				Refs:        []string{"main", "refs/tags/v*"},
+			},
+		},
+		{
+			name: "bitbucket",
+			spec: v1alpha1.RepositorySpec{
+				Type:      v1alpha1.RepositoryTypeBitbucket,
+				Bitbucket: &v1alpha1.BitbucketRepositorySpec{Workspace: "workspace", Name: "repository", Branch: "master", URL: "bitbucket.org/workspace/repository"},
+			},
+			want: model.Repository{
+				Owner:       "workspace",
+				Name:        "repository",
+				Type:        model.RepositoryTypeBitbucket,
+				URL:         "bitbucket.org/workspace/repository",
+				DisplayName: "workspace/repository",
+				Refs:        []string{"master"},
+			},
+		},
+		{
+			name: "git",
+			spec: v1alpha1.RepositorySpec{
+				Type: v1alpha1.RepositoryTypeGit,
+				Git:  &v1alpha1.GitRepositorySpec{URL: "example.com/team/repo", CloneURL: "git@example.com:team/repo.git", Branch: "main"},
+			},
+			want: model.Repository{
+				Owner:       "example.com/team",
+				Name:        "repo",
+				Type:        model.RepositoryTypeGit,
+				URL:         "example.com/team/repo",
+				DisplayName: "example.com/team/repo",
+				Refs:        []string{"main"},
+			},
+		},
+		{
+			name:    "missing gitlab spec",
+			spec:    v1alpha1.RepositorySpec{Type: v1alpha1.RepositoryTypeGitlab},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &v1alpha1.Repository{Spec: tt.spec}
+			repo.Name = "repository"
+			got, err := RepositoryCRDToModel(repo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RepositoryCRDToModel() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			tt.want.ID = "repository"
+			if !reflect.DeepEqual(*got, tt.want) {
+				t.Errorf("RepositoryCRDToModel() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
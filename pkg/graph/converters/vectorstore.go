@@ -0,0 +1,34 @@
+package converters
+
+import (
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/graph/model"
+	"github.com/encoder-run/operator/pkg/vectorstore"
+)
+
+func MatchToSearchResult(m *vectorstore.Match, repo *v1alpha1.Repository) (*model.SearchResult, error) {
+	sr := &model.SearchResult{}
+	sr.ID = m.ID
+	owner, name, err := repositoryOwnerAndName(repo)
+	if err != nil {
+		return nil, err
+	}
+
+	sr.Owner = owner
+	sr.Repo = name
+	sr.ChunkID = m.ChunkID
+	sr.Hash = m.FileHash
+	sr.Path = m.FilePath
+	sr.Score = m.Distance
+	sr.StartIndex = m.StartIndex
+	sr.EndIndex = m.EndIndex
+	if m.Symbol != "" {
+		sr.Symbol = &m.Symbol
+	}
+	sr.Refs = m.Refs
+	if sr.Refs == nil {
+		sr.Refs = []string{}
+	}
+
+	return sr, nil
+}
@@ -17,6 +17,9 @@ type AddModelDeploymentInput struct {
 type AddModelInput struct {
 	Type        ModelType         `json:"type"`
 	HuggingFace *HuggingFaceInput `json:"huggingFace,omitempty"`
+	OpenAi      *OpenAIInput      `json:"openAI,omitempty"`
+	External    *ExternalInput    `json:"external,omitempty"`
+	Dimension   *int              `json:"dimension,omitempty"`
 }
 
 type AddPipelineDeploymentInput struct {
@@ -28,21 +31,35 @@ type AddPipelineInput struct {
 	Type                 PipelineType                  `json:"type"`
 	Name                 string                        `json:"name"`
 	RepositoryEmbeddings *AddRepositoryEmbeddingsInput `json:"repositoryEmbeddings,omitempty"`
+	ConcurrencyPolicy    *ConcurrencyPolicy            `json:"concurrencyPolicy,omitempty"`
+	RetryPolicy          *RetryPolicyInput             `json:"retryPolicy,omitempty"`
+	ExecutionHistory     *ExecutionHistoryInput        `json:"executionHistory,omitempty"`
+	Schedule             *ScheduleInput                `json:"schedule,omitempty"`
 }
 
 type AddRepositoryEmbeddingsInput struct {
-	RepositoryID string `json:"repositoryID"`
-	ModelID      string `json:"modelID"`
-	StorageID    string `json:"storageID"`
+	RepositoryID string         `json:"repositoryID"`
+	ModelID      string         `json:"modelID"`
+	StorageID    string         `json:"storageID"`
+	Chunking     *ChunkingInput `json:"chunking,omitempty"`
+	Snapshots    *int           `json:"snapshots,omitempty"`
+	Shards       *int           `json:"shards,omitempty"`
 }
 
 type AddRepositoryInput struct {
-	URL    *string         `json:"url,omitempty"`
-	Token  *string         `json:"token,omitempty"`
-	Type   *RepositoryType `json:"type,omitempty"`
-	Owner  *string         `json:"owner,omitempty"`
-	Name   *string         `json:"name,omitempty"`
-	Branch *string         `json:"branch,omitempty"`
+	URL           *string         `json:"url,omitempty"`
+	Token         *string         `json:"token,omitempty"`
+	Username      *string         `json:"username,omitempty"`
+	Type          *RepositoryType `json:"type,omitempty"`
+	Owner         *string         `json:"owner,omitempty"`
+	Name          *string         `json:"name,omitempty"`
+	Branch        *string         `json:"branch,omitempty"`
+	Refs          []string        `json:"refs,omitempty"`
+	BaseURL       *string         `json:"baseURL,omitempty"`
+	SSHPrivateKey *string         `json:"sshPrivateKey,omitempty"`
+	SSHPassphrase *string         `json:"sshPassphrase,omitempty"`
+	KnownHosts    *string         `json:"knownHosts,omitempty"`
+	WebhookSecret *string         `json:"webhookSecret,omitempty"`
 }
 
 type AddStorageDeploymentInput struct {
@@ -57,6 +74,70 @@ type AddStorageInput struct {
 	Postgres *PostgresInput `json:"postgres,omitempty"`
 }
 
+type Chunking struct {
+	Strategy ChunkingStrategy `json:"strategy"`
+	Size     int              `json:"size"`
+	Overlap  int              `json:"overlap"`
+}
+
+type ChunkingInput struct {
+	Strategy ChunkingStrategy `json:"strategy"`
+	Size     *int             `json:"size,omitempty"`
+	Overlap  *int             `json:"overlap,omitempty"`
+}
+
+type ExecutionAttempt struct {
+	Attempt        int                     `json:"attempt"`
+	Status         PipelineExecutionStatus `json:"status"`
+	StartTime      string                  `json:"startTime"`
+	CompletionTime *string                 `json:"completionTime,omitempty"`
+	Reason         *FailureReason          `json:"reason,omitempty"`
+	Message        *string                 `json:"message,omitempty"`
+}
+
+type ExecutionHistory struct {
+	SuccessfulExecutionsLimit *int `json:"successfulExecutionsLimit,omitempty"`
+	FailedExecutionsLimit     *int `json:"failedExecutionsLimit,omitempty"`
+	TTLSecondsAfterFinished   *int `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+type ExecutionHistoryInput struct {
+	SuccessfulExecutionsLimit *int `json:"successfulExecutionsLimit,omitempty"`
+	FailedExecutionsLimit     *int `json:"failedExecutionsLimit,omitempty"`
+	TTLSecondsAfterFinished   *int `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+type ExecutionProgress struct {
+	FilesProcessed          int     `json:"filesProcessed"`
+	FilesTotal              int     `json:"filesTotal"`
+	Batch                   int     `json:"batch"`
+	EstimatedCompletionTime *string `json:"estimatedCompletionTime,omitempty"`
+	UpdateTime              string  `json:"updateTime"`
+}
+
+type ExecutionStats struct {
+	FilesScanned  int `json:"filesScanned"`
+	FilesSkipped  int `json:"filesSkipped"`
+	FilesEmbedded int `json:"filesEmbedded"`
+	FilesDeleted  int `json:"filesDeleted"`
+	ChunksWritten int `json:"chunksWritten"`
+}
+
+type External struct {
+	Endpoint   string              `json:"endpoint"`
+	Format     ExternalModelFormat `json:"format"`
+	Model      *string             `json:"model,omitempty"`
+	AuthHeader string              `json:"authHeader"`
+}
+
+type ExternalInput struct {
+	Endpoint   string               `json:"endpoint"`
+	Format     *ExternalModelFormat `json:"format,omitempty"`
+	Model      *string              `json:"model,omitempty"`
+	AuthHeader *string              `json:"authHeader,omitempty"`
+	Token      *string              `json:"token,omitempty"`
+}
+
 type HuggingFace struct {
 	Organization      string `json:"organization"`
 	Name              string `json:"name"`
@@ -69,13 +150,22 @@ type HuggingFaceInput struct {
 	MaxSequenceLength int    `json:"maxSequenceLength"`
 }
 
+type IndexedCommit struct {
+	Ref    string `json:"ref"`
+	Commit string `json:"commit"`
+	Tree   string `json:"tree"`
+}
+
 type Model struct {
 	ID          string           `json:"id"`
 	Type        ModelType        `json:"type"`
 	DisplayName string           `json:"displayName"`
 	Status      ModelStatus      `json:"status"`
 	HuggingFace *HuggingFace     `json:"huggingFace,omitempty"`
+	OpenAi      *OpenAi          `json:"openAI,omitempty"`
+	External    *External        `json:"external,omitempty"`
 	Deployment  *ModelDeployment `json:"deployment,omitempty"`
+	Dimension   int              `json:"dimension"`
 }
 
 type ModelDeployment struct {
@@ -87,6 +177,17 @@ type ModelDeployment struct {
 type Mutation struct {
 }
 
+type OpenAi struct {
+	BaseURL string `json:"baseURL"`
+	Model   string `json:"model"`
+}
+
+type OpenAIInput struct {
+	BaseURL *string `json:"baseURL,omitempty"`
+	Model   string  `json:"model"`
+	Token   *string `json:"token,omitempty"`
+}
+
 type Pipeline struct {
 	ID                   string                `json:"id"`
 	Name                 string                `json:"name"`
@@ -94,11 +195,55 @@ type Pipeline struct {
 	Enabled              bool                  `json:"enabled"`
 	Status               PipelineStatus        `json:"status"`
 	RepositoryEmbeddings *RepositoryEmbeddings `json:"repositoryEmbeddings,omitempty"`
+	ConcurrencyPolicy    ConcurrencyPolicy     `json:"concurrencyPolicy"`
+	RetryPolicy          *RetryPolicy          `json:"retryPolicy"`
+	ExecutionHistory     *ExecutionHistory     `json:"executionHistory"`
+	Schedule             *Schedule             `json:"schedule,omitempty"`
+	LastScheduleTime     *string               `json:"lastScheduleTime,omitempty"`
+	Snapshots            []*IndexedCommit      `json:"snapshots"`
 }
 
 type PipelineExecution struct {
-	ID     string                  `json:"id"`
-	Status PipelineExecutionStatus `json:"status"`
+	ID              string                  `json:"id"`
+	Status          PipelineExecutionStatus `json:"status"`
+	StartTime       *string                 `json:"startTime,omitempty"`
+	CompletionTime  *string                 `json:"completionTime,omitempty"`
+	DurationSeconds *int                    `json:"durationSeconds,omitempty"`
+	Reason          *FailureReason          `json:"reason,omitempty"`
+	Message         *string                 `json:"message,omitempty"`
+	Stats           *ExecutionStats         `json:"stats,omitempty"`
+	Progress        *ExecutionProgress      `json:"progress,omitempty"`
+	Commits         []*IndexedCommit        `json:"commits"`
+	Attempts        []*ExecutionAttempt     `json:"attempts"`
+	NextAttemptTime *string                 `json:"nextAttemptTime,omitempty"`
+}
+
+type PipelineExecutionConnection struct {
+	Executions  []*PipelineExecution `json:"executions"`
+	EndCursor   *string              `json:"endCursor,omitempty"`
+	HasNextPage bool                 `json:"hasNextPage"`
+	TotalCount  int                  `json:"totalCount"`
+}
+
+type PipelineExecutionLogLine struct {
+	Text  *string `json:"text,omitempty"`
+	Error *string `json:"error,omitempty"`
+}
+
+type PipelineExecutionLogsInput struct {
+	ID         string `json:"id"`
+	Attempt    *int   `json:"attempt,omitempty"`
+	Shard      *int   `json:"shard,omitempty"`
+	TailLines  *int   `json:"tailLines,omitempty"`
+	LimitLines *int   `json:"limitLines,omitempty"`
+	Follow     *bool  `json:"follow,omitempty"`
+}
+
+type PipelineExecutionsInput struct {
+	PipelineID string                    `json:"pipelineID"`
+	States     []PipelineExecutionStatus `json:"states,omitempty"`
+	First      *int                      `json:"first,omitempty"`
+	After      *string                   `json:"after,omitempty"`
 }
 
 type PostgresInput struct {
@@ -116,9 +261,11 @@ type Query struct {
 }
 
 type QueryInput struct {
-	Query string `json:"query"`
-	Page  *int   `json:"page,omitempty"`
-	Limit *int   `json:"limit,omitempty"`
+	Query    string  `json:"query"`
+	Page     *int    `json:"page,omitempty"`
+	Limit    *int    `json:"limit,omitempty"`
+	Ref      *string `json:"ref,omitempty"`
+	Snapshot *string `json:"snapshot,omitempty"`
 }
 
 type Repository struct {
@@ -128,26 +275,80 @@ type Repository struct {
 	Owner       string         `json:"owner"`
 	Name        string         `json:"name"`
 	URL         string         `json:"url"`
+	Refs        []string       `json:"refs"`
 }
 
 type RepositoryEmbeddings struct {
-	RepositoryID string `json:"repositoryID"`
-	ModelID      string `json:"modelID"`
-	StorageID    string `json:"storageID"`
+	RepositoryID string    `json:"repositoryID"`
+	ModelID      string    `json:"modelID"`
+	StorageID    string    `json:"storageID"`
+	Dimension    int       `json:"dimension"`
+	Chunking     *Chunking `json:"chunking,omitempty"`
+	Snapshots    int       `json:"snapshots"`
+	Shards       int       `json:"shards"`
+}
+
+type RetryPolicy struct {
+	MaxAttempts       int             `json:"maxAttempts"`
+	BackoffSeconds    int             `json:"backoffSeconds"`
+	MaxBackoffSeconds int             `json:"maxBackoffSeconds"`
+	RetryOn           []FailureReason `json:"retryOn"`
+}
+
+type RetryPolicyInput struct {
+	MaxAttempts       *int            `json:"maxAttempts,omitempty"`
+	BackoffSeconds    *int            `json:"backoffSeconds,omitempty"`
+	MaxBackoffSeconds *int            `json:"maxBackoffSeconds,omitempty"`
+	RetryOn           []FailureReason `json:"retryOn,omitempty"`
+}
+
+type Schedule struct {
+	Cron                    string  `json:"cron"`
+	TimeZone                *string `json:"timeZone,omitempty"`
+	StartingDeadlineSeconds *int    `json:"startingDeadlineSeconds,omitempty"`
+}
+
+type ScheduleInput struct {
+	Cron                    string  `json:"cron"`
+	TimeZone                *string `json:"timeZone,omitempty"`
+	StartingDeadlineSeconds *int    `json:"startingDeadlineSeconds,omitempty"`
 }
 
 type SearchResult struct {
-	ID         string  `json:"id"`
-	ChunkID    int     `json:"chunkID"`
-	Content    string  `json:"content"`
-	Hash       string  `json:"hash"`
-	Path       string  `json:"path"`
-	Owner      string  `json:"owner"`
-	Repo       string  `json:"repo"`
-	StartIndex int     `json:"startIndex"`
-	EndIndex   int     `json:"endIndex"`
-	StartLine  int     `json:"startLine"`
-	Score      float64 `json:"score"`
+	ID         string   `json:"id"`
+	ChunkID    int      `json:"chunkID"`
+	Content    string   `json:"content"`
+	Hash       string   `json:"hash"`
+	Path       string   `json:"path"`
+	Owner      string   `json:"owner"`
+	Repo       string   `json:"repo"`
+	StartIndex int      `json:"startIndex"`
+	EndIndex   int      `json:"endIndex"`
+	StartLine  int      `json:"startLine"`
+	Score      float64  `json:"score"`
+	Symbol     *string  `json:"symbol,omitempty"`
+	Refs       []string `json:"refs"`
+	Commit     *string  `json:"commit,omitempty"`
+}
+
+type SetPipelineConcurrencyPolicyInput struct {
+	ID                string            `json:"id"`
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy"`
+}
+
+type SetPipelineExecutionHistoryInput struct {
+	ID               string                 `json:"id"`
+	ExecutionHistory *ExecutionHistoryInput `json:"executionHistory,omitempty"`
+}
+
+type SetPipelineRetryPolicyInput struct {
+	ID          string            `json:"id"`
+	RetryPolicy *RetryPolicyInput `json:"retryPolicy,omitempty"`
+}
+
+type SetPipelineScheduleInput struct {
+	ID       string         `json:"id"`
+	Schedule *ScheduleInput `json:"schedule,omitempty"`
 }
 
 type Storage struct {
@@ -164,6 +365,185 @@ type StorageDeployment struct {
 	Memory  string `json:"memory"`
 }
 
+type Subscription struct {
+}
+
+type ChunkingStrategy string
+
+const (
+	ChunkingStrategyTokens   ChunkingStrategy = "TOKENS"
+	ChunkingStrategyLines    ChunkingStrategy = "LINES"
+	ChunkingStrategyLanguage ChunkingStrategy = "LANGUAGE"
+	ChunkingStrategySyntax   ChunkingStrategy = "SYNTAX"
+)
+
+var AllChunkingStrategy = []ChunkingStrategy{
+	ChunkingStrategyTokens,
+	ChunkingStrategyLines,
+	ChunkingStrategyLanguage,
+	ChunkingStrategySyntax,
+}
+
+func (e ChunkingStrategy) IsValid() bool {
+	switch e {
+	case ChunkingStrategyTokens, ChunkingStrategyLines, ChunkingStrategyLanguage, ChunkingStrategySyntax:
+		return true
+	}
+	return false
+}
+
+func (e ChunkingStrategy) String() string {
+	return string(e)
+}
+
+func (e *ChunkingStrategy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChunkingStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChunkingStrategy", str)
+	}
+	return nil
+}
+
+func (e ChunkingStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ConcurrencyPolicy string
+
+const (
+	ConcurrencyPolicyAllow   ConcurrencyPolicy = "ALLOW"
+	ConcurrencyPolicyForbid  ConcurrencyPolicy = "FORBID"
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "REPLACE"
+	ConcurrencyPolicyQueue   ConcurrencyPolicy = "QUEUE"
+)
+
+var AllConcurrencyPolicy = []ConcurrencyPolicy{
+	ConcurrencyPolicyAllow,
+	ConcurrencyPolicyForbid,
+	ConcurrencyPolicyReplace,
+	ConcurrencyPolicyQueue,
+}
+
+func (e ConcurrencyPolicy) IsValid() bool {
+	switch e {
+	case ConcurrencyPolicyAllow, ConcurrencyPolicyForbid, ConcurrencyPolicyReplace, ConcurrencyPolicyQueue:
+		return true
+	}
+	return false
+}
+
+func (e ConcurrencyPolicy) String() string {
+	return string(e)
+}
+
+func (e *ConcurrencyPolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ConcurrencyPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ConcurrencyPolicy", str)
+	}
+	return nil
+}
+
+func (e ConcurrencyPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ExternalModelFormat string
+
+const (
+	ExternalModelFormatEncoder ExternalModelFormat = "ENCODER"
+	ExternalModelFormatOpenai  ExternalModelFormat = "OPENAI"
+)
+
+var AllExternalModelFormat = []ExternalModelFormat{
+	ExternalModelFormatEncoder,
+	ExternalModelFormatOpenai,
+}
+
+func (e ExternalModelFormat) IsValid() bool {
+	switch e {
+	case ExternalModelFormatEncoder, ExternalModelFormatOpenai:
+		return true
+	}
+	return false
+}
+
+func (e ExternalModelFormat) String() string {
+	return string(e)
+}
+
+func (e *ExternalModelFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExternalModelFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExternalModelFormat", str)
+	}
+	return nil
+}
+
+func (e ExternalModelFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FailureReason string
+
+const (
+	FailureReasonError            FailureReason = "ERROR"
+	FailureReasonOomKilled        FailureReason = "OOM_KILLED"
+	FailureReasonEvicted          FailureReason = "EVICTED"
+	FailureReasonDeadlineExceeded FailureReason = "DEADLINE_EXCEEDED"
+)
+
+var AllFailureReason = []FailureReason{
+	FailureReasonError,
+	FailureReasonOomKilled,
+	FailureReasonEvicted,
+	FailureReasonDeadlineExceeded,
+}
+
+func (e FailureReason) IsValid() bool {
+	switch e {
+	case FailureReasonError, FailureReasonOomKilled, FailureReasonEvicted, FailureReasonDeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+func (e FailureReason) String() string {
+	return string(e)
+}
+
+func (e *FailureReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FailureReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FailureReason", str)
+	}
+	return nil
+}
+
+func (e FailureReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ModelStatus string
 
 const (
@@ -259,6 +639,8 @@ const (
 	PipelineExecutionStatusSucceeded PipelineExecutionStatus = "SUCCEEDED"
 	PipelineExecutionStatusFailed    PipelineExecutionStatus = "FAILED"
 	PipelineExecutionStatusPending   PipelineExecutionStatus = "PENDING"
+	PipelineExecutionStatusSkipped   PipelineExecutionStatus = "SKIPPED"
+	PipelineExecutionStatusCancelled PipelineExecutionStatus = "CANCELLED"
 )
 
 var AllPipelineExecutionStatus = []PipelineExecutionStatus{
@@ -266,11 +648,13 @@ var AllPipelineExecutionStatus = []PipelineExecutionStatus{
 	PipelineExecutionStatusSucceeded,
 	PipelineExecutionStatusFailed,
 	PipelineExecutionStatusPending,
+	PipelineExecutionStatusSkipped,
+	PipelineExecutionStatusCancelled,
 }
 
 func (e PipelineExecutionStatus) IsValid() bool {
 	switch e {
-	case PipelineExecutionStatusActive, PipelineExecutionStatusSucceeded, PipelineExecutionStatusFailed, PipelineExecutionStatusPending:
+	case PipelineExecutionStatusActive, PipelineExecutionStatusSucceeded, PipelineExecutionStatusFailed, PipelineExecutionStatusPending, PipelineExecutionStatusSkipped, PipelineExecutionStatusCancelled:
 		return true
 	}
 	return false
@@ -385,17 +769,19 @@ const (
 	RepositoryTypeGithub    RepositoryType = "GITHUB"
 	RepositoryTypeGitlab    RepositoryType = "GITLAB"
 	RepositoryTypeBitbucket RepositoryType = "BITBUCKET"
+	RepositoryTypeGit       RepositoryType = "GIT"
 )
 
 var AllRepositoryType = []RepositoryType{
 	RepositoryTypeGithub,
 	RepositoryTypeGitlab,
 	RepositoryTypeBitbucket,
+	RepositoryTypeGit,
 }
 
 func (e RepositoryType) IsValid() bool {
 	switch e {
-	case RepositoryTypeGithub, RepositoryTypeGitlab, RepositoryTypeBitbucket:
+	case RepositoryTypeGithub, RepositoryTypeGitlab, RepositoryTypeBitbucket, RepositoryTypeGit:
 		return true
 	}
 	return false
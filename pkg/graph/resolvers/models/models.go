@@ -8,7 +8,9 @@ import (
 	"github.com/encoder-run/operator/pkg/common"
 	"github.com/encoder-run/operator/pkg/graph/converters"
 	"github.com/encoder-run/operator/pkg/graph/model"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -78,6 +80,27 @@ func Add(ctx context.Context, input model.AddModelInput) (*model.Model, error) {
 		return nil, err
 	}
 
+	// Create the secret holding the token with the name of the model.
+	var token *string
+	switch {
+	case input.OpenAi != nil:
+		token = input.OpenAi.Token
+	case input.External != nil:
+		token = input.External.Token
+	}
+	if token != nil && *token != "" {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      modelCRD.Name,
+				Namespace: modelCRD.Namespace,
+			},
+			Data: map[string][]byte{"token": []byte(*token)},
+		}
+		if err := ctrlClient.Create(ctx, secret); err != nil {
+			return nil, err
+		}
+	}
+
 	// Convert the model to the model.
 	m, err := converters.ModelCRDToModel(modelCRD)
 	if err != nil {
@@ -110,6 +133,11 @@ func AddDeployment(ctx context.Context, input model.AddModelDeploymentInput) (*m
 		return nil, err
 	}
 
+	// Only models served by KServe can be deployed.
+	if modelCRD.Spec.Type != v1alpha1.ModelTypeHuggingFace {
+		return nil, fmt.Errorf("models of type %s cannot be deployed", modelCRD.Spec.Type)
+	}
+
 	// Update the model with the deployment.
 	modelCRD.Spec.Deployment = &v1alpha1.ModelDeploymentSpec{
 		Enabled: true,
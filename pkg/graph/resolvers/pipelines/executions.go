@@ -0,0 +1,131 @@
+package pipelines
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/common"
+	"github.com/encoder-run/operator/pkg/graph/converters"
+	"github.com/encoder-run/operator/pkg/graph/model"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// defaultExecutionsPageSize is the number of executions of a page if none is requested.
+	defaultExecutionsPageSize = 20
+	// maxExecutionsPageSize is the maximum number of executions of a page.
+	maxExecutionsPageSize = 100
+)
+
+// ExecutionsPage returns a page of the executions of the pipeline in the states, newest
+// first. The cursor of an execution is its creation time and name, so pages stay stable
+// while executions are created or deleted.
+func ExecutionsPage(ctx context.Context, input model.PipelineExecutionsInput) (*model.PipelineExecutionConnection, error) {
+	// Get the controller-runtime client from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+
+	first := defaultExecutionsPageSize
+	if input.First != nil {
+		first = *input.First
+	}
+	if first <= 0 || first > maxExecutionsPageSize {
+		return nil, fmt.Errorf("first must be between 1 and %d", maxExecutionsPageSize)
+	}
+
+	var after *executionCursor
+	if input.After != nil {
+		cursor, err := parseExecutionCursor(*input.After)
+		if err != nil {
+			return nil, err
+		}
+		after = &cursor
+	}
+
+	// The status of the executions can't be selected by the API server, so the
+	// executions are filtered here.
+	executionList := &v1alpha1.PipelineExecutionList{}
+	if err := ctrlClient.List(ctx, executionList, client.InNamespace("default"), client.MatchingLabels{"pipelineId": input.PipelineID}); err != nil {
+		return nil, fmt.Errorf("error listing pipeline executions: %w", err)
+	}
+	items := executionList.Items
+	sort.Slice(items, func(i, j int) bool {
+		return cursorOf(&items[i]).before(cursorOf(&items[j]))
+	})
+
+	conn := &model.PipelineExecutionConnection{Executions: make([]*model.PipelineExecution, 0)}
+	for i := range items {
+		if items[i].Spec.PipelineRef.Name != input.PipelineID {
+			continue
+		}
+		e, err := converters.PipelineExecutionCRDToModel(&items[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(input.States) > 0 && !slices.Contains(input.States, e.Status) {
+			continue
+		}
+		conn.TotalCount++
+
+		cursor := cursorOf(&items[i])
+		if after != nil && !after.before(cursor) {
+			continue
+		}
+		if len(conn.Executions) == first {
+			conn.HasNextPage = true
+			continue
+		}
+		conn.Executions = append(conn.Executions, e)
+		end := cursor.String()
+		conn.EndCursor = &end
+	}
+
+	return conn, nil
+}
+
+// executionCursor is the position of an execution in the executions ordered newest first.
+type executionCursor struct {
+	created int64
+	name    string
+}
+
+func cursorOf(execution *v1alpha1.PipelineExecution) executionCursor {
+	return executionCursor{created: execution.CreationTimestamp.Unix(), name: execution.Name}
+}
+
+// before returns true if the execution of the cursor is newer than the other one,
+// executions created in the same second are ordered by name.
+func (c executionCursor) before(other executionCursor) bool {
+	if c.created != other.created {
+		return c.created > other.created
+	}
+	return c.name < other.name
+}
+
+func (c executionCursor) String() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d/%s", c.created, c.name)))
+}
+
+func parseExecutionCursor(s string) (executionCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return executionCursor{}, fmt.Errorf("invalid cursor: %s", s)
+	}
+	created, name, ok := strings.Cut(string(b), "/")
+	if !ok {
+		return executionCursor{}, fmt.Errorf("invalid cursor: %s", s)
+	}
+	c := executionCursor{name: name}
+	if c.created, err = strconv.ParseInt(created, 10, 64); err != nil {
+		return executionCursor{}, fmt.Errorf("invalid cursor: %s", s)
+	}
+	return c, nil
+}
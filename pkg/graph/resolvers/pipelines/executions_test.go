@@ -0,0 +1,70 @@
+package pipelines
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestExecutionCursor(t *testing.T) {
+	tests := []struct {
+		name   string
+		cursor executionCursor
+	}{
+		{name: "execution", cursor: executionCursor{created: 1710072000, name: "pipeline-execution-x7k2p"}},
+		{name: "scheduled execution", cursor: executionCursor{created: 1710072000, name: "nightly-28501200"}},
+		{name: "zero creation time", cursor: executionCursor{created: 0, name: "pipeline-execution-a"}},
+		{name: "name with a slash", cursor: executionCursor{created: 1, name: "a/b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseExecutionCursor(tt.cursor.String())
+			if err != nil {
+				t.Fatalf("parseExecutionCursor(%q) error = %v", tt.cursor.String(), err)
+			}
+			if got != tt.cursor {
+				t.Errorf("parseExecutionCursor(%q) = %+v, want %+v", tt.cursor.String(), got, tt.cursor)
+			}
+		})
+	}
+}
+
+func TestParseExecutionCursorInvalid(t *testing.T) {
+	encode := func(s string) string { return base64.RawURLEncoding.EncodeToString([]byte(s)) }
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{name: "empty", cursor: ""},
+		{name: "not base64", cursor: "not a cursor!"},
+		{name: "no separator", cursor: encode("1710072000")},
+		{name: "creation time not a number", cursor: encode("yesterday/pipeline-execution-a")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := parseExecutionCursor(tt.cursor); err == nil {
+				t.Errorf("parseExecutionCursor(%q) succeeded, want an error", tt.cursor)
+			}
+		})
+	}
+}
+
+func TestExecutionCursorBefore(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b executionCursor
+		want bool
+	}{
+		{name: "newer first", a: executionCursor{created: 2, name: "b"}, b: executionCursor{created: 1, name: "a"}, want: true},
+		{name: "older after", a: executionCursor{created: 1, name: "a"}, b: executionCursor{created: 2, name: "b"}, want: false},
+		{name: "same second by name", a: executionCursor{created: 1, name: "a"}, b: executionCursor{created: 1, name: "b"}, want: true},
+		{name: "same second after by name", a: executionCursor{created: 1, name: "b"}, b: executionCursor{created: 1, name: "a"}, want: false},
+		{name: "same execution", a: executionCursor{created: 1, name: "a"}, b: executionCursor{created: 1, name: "a"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.before(tt.b); got != tt.want {
+				t.Errorf("%+v.before(%+v) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package pipelines
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/common"
+	"github.com/encoder-run/operator/pkg/graph/model"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// embedderContainer is the name of the container of the jobs of the executions.
+	embedderContainer = "repoembedder-container"
+	// defaultLogLines is the number of lines returned by the logs query if no limit is set.
+	defaultLogLines = 1000
+	// maxLogLines is the maximum number of lines that can be requested at once.
+	maxLogLines = 10000
+	// podPollInterval is the interval a followed pod is fetched at until it started.
+	podPollInterval = 2 * time.Second
+)
+
+// Logs returns the log lines of the embedder of the attempt of the execution.
+func Logs(ctx context.Context, input model.PipelineExecutionLogsInput) ([]string, error) {
+	limit := defaultLogLines
+	if input.LimitLines != nil {
+		limit = *input.LimitLines
+	}
+	if limit <= 0 || limit > maxLogLines {
+		return nil, fmt.Errorf("limitLines must be between 1 and %d", maxLogLines)
+	}
+
+	clientset, pod, err := executionPod(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions(input, false)).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading logs of pod %s: %w", pod.Name, err)
+	}
+	defer stream.Close()
+
+	lines := make([]string, 0)
+	scanner := logScanner(stream)
+	for len(lines) < limit && scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading logs of pod %s: %w", pod.Name, err)
+	}
+
+	return lines, nil
+}
+
+// StreamLogs sends the log lines of the embedder of the attempt of the execution. If
+// follow is set, new lines are sent until the embedder exits, waiting for the pod to
+// start first. Failures once the subscription started are sent as its last line.
+func StreamLogs(ctx context.Context, input model.PipelineExecutionLogsInput) (<-chan *model.PipelineExecutionLogLine, error) {
+	limit := 0
+	if input.LimitLines != nil {
+		limit = *input.LimitLines
+		if limit <= 0 || limit > maxLogLines {
+			return nil, fmt.Errorf("limitLines must be between 1 and %d", maxLogLines)
+		}
+	}
+	follow := input.Follow != nil && *input.Follow
+
+	clientset, pod, err := executionPod(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// Logs can't be read until the container started, the stream of a followed pod is
+	// opened once it did.
+	var stream io.ReadCloser
+	if !follow || pod.Status.Phase != v1.PodPending {
+		stream, err = clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions(input, follow)).Stream(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error reading logs of pod %s: %w", pod.Name, err)
+		}
+	}
+
+	ch := make(chan *model.PipelineExecutionLogLine)
+	go func() {
+		defer close(ch)
+
+		// send returns false once the subscription ended.
+		send := func(line *model.PipelineExecutionLogLine) bool {
+			select {
+			case ch <- line:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if stream == nil {
+			var err error
+			stream, err = followPod(ctx, clientset, pod, input)
+			if err != nil {
+				if ctx.Err() == nil {
+					send(logError(err))
+				}
+				return
+			}
+		}
+		defer stream.Close()
+
+		scanner := logScanner(stream)
+		for sent := 0; (limit == 0 || sent < limit) && scanner.Scan(); sent++ {
+			text := scanner.Text()
+			if !send(&model.PipelineExecutionLogLine{Text: &text}) {
+				return
+			}
+		}
+		if err := scanner.Err(); err != nil && ctx.Err() == nil {
+			send(logError(fmt.Errorf("error reading logs of pod %s: %w", pod.Name, err)))
+		}
+	}()
+	return ch, nil
+}
+
+// logError returns the line reporting the failure to read the logs.
+func logError(err error) *model.PipelineExecutionLogLine {
+	msg := err.Error()
+	return &model.PipelineExecutionLogLine{Error: &msg}
+}
+
+// followPod waits for the pending pod to start and opens the stream of its logs.
+func followPod(ctx context.Context, clientset kubernetes.Interface, pod *v1.Pod, input model.PipelineExecutionLogsInput) (io.ReadCloser, error) {
+	for pod.Status.Phase == v1.PodPending {
+		select {
+		case <-time.After(podPollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		current, err := clientset.CoreV1().Pods(pod.Namespace).Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("error getting pod %s: %w", pod.Name, err)
+		}
+		pod = current
+	}
+	stream, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, logOptions(input, true)).Stream(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error reading logs of pod %s: %w", pod.Name, err)
+	}
+	return stream, nil
+}
+
+// executionPod returns the clientset from the context and the pod of the attempt of the execution.
+func executionPod(ctx context.Context, input model.PipelineExecutionLogsInput) (kubernetes.Interface, *v1.Pod, error) {
+	// Get the controller-runtime client and the clientset from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+	clientset, ok := ctx.Value(common.AdminClientsetKey).(kubernetes.Interface)
+	if !ok {
+		return nil, nil, fmt.Errorf("clientset not found in context")
+	}
+
+	executionCRD := &v1alpha1.PipelineExecution{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: input.ID}, executionCRD); err != nil {
+		return nil, nil, err
+	}
+
+	attempts := executionCRD.Status.Attempts
+	if len(attempts) == 0 {
+		return nil, nil, fmt.Errorf("pipeline execution %s has not started", input.ID)
+	}
+	attempt := &attempts[len(attempts)-1]
+	if input.Attempt != nil {
+		attempt = nil
+		for i := range attempts {
+			if int(attempts[i].Attempt) == *input.Attempt {
+				attempt = &attempts[i]
+			}
+		}
+		if attempt == nil {
+			return nil, nil, fmt.Errorf("attempt %d of pipeline execution %s not found", *input.Attempt, input.ID)
+		}
+	}
+
+	// The jobs of the attempts don't retry, so they have a single pod, or one per shard.
+	jobName := attempt.JobName
+	if attempt.FinalizeJobName != "" && input.Shard == nil {
+		jobName = attempt.FinalizeJobName
+	}
+	pods := &v1.PodList{}
+	if err := ctrlClient.List(ctx, pods, client.InNamespace(executionCRD.Namespace), client.MatchingLabels{"job-name": jobName}); err != nil {
+		return nil, nil, fmt.Errorf("error listing pods of job %s: %w", jobName, err)
+	}
+	var pod *v1.Pod
+	for i := range pods.Items {
+		if input.Shard != nil && pods.Items[i].Annotations[batchv1.JobCompletionIndexAnnotation] != strconv.Itoa(*input.Shard) {
+			continue
+		}
+		if pod == nil || pod.CreationTimestamp.Before(&pods.Items[i].CreationTimestamp) {
+			pod = &pods.Items[i]
+		}
+	}
+	if pod == nil {
+		return nil, nil, fmt.Errorf("no pod of attempt %d of pipeline execution %s found", attempt.Attempt, input.ID)
+	}
+
+	return clientset, pod, nil
+}
+
+func logOptions(input model.PipelineExecutionLogsInput, follow bool) *v1.PodLogOptions {
+	opts := &v1.PodLogOptions{
+		Container: embedderContainer,
+		Follow:    follow,
+	}
+	if input.TailLines != nil {
+		tail := int64(*input.TailLines)
+		opts.TailLines = &tail
+	}
+	return opts
+}
+
+// logScanner scans the lines of the logs, long lines like the JSON of failed
+// requests are kept whole.
+func logScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	return scanner
+}
@@ -0,0 +1,231 @@
+package pipelines
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/common"
+	"github.com/encoder-run/operator/pkg/graph/model"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
+
+// adminContext returns a context with the admin clients of the objects.
+func adminContext(objs ...client.Object) context.Context {
+	scheme := runtime.NewScheme()
+	_ = v1.AddToScheme(scheme)
+	_ = v1alpha1.AddToScheme(scheme)
+	ctrlClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	ctx := context.WithValue(context.Background(), common.AdminClientKey, ctrlClient)
+	return context.WithValue(ctx, common.AdminClientsetKey, kubefake.NewSimpleClientset())
+}
+
+// jobPod returns a pod of the job created at the time.
+func jobPod(name, job string, created time.Time) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:              name,
+		Namespace:         "default",
+		Labels:            map[string]string{"job-name": job},
+		CreationTimestamp: metav1.NewTime(created),
+	}}
+}
+
+func TestExecutionPod(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	execution := &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "execution", Namespace: "default"},
+		Status: v1alpha1.PipelineExecutionStatus{Attempts: []v1alpha1.ExecutionAttempt{
+			{Attempt: 1, JobName: "execution"},
+			{Attempt: 2, JobName: "execution-2"},
+		}},
+	}
+	pending := &v1alpha1.PipelineExecution{ObjectMeta: metav1.ObjectMeta{Name: "pending", Namespace: "default"}}
+	ctx := adminContext(execution, pending,
+		jobPod("execution-abcde", "execution", now),
+		jobPod("execution-2-old", "execution-2", now.Add(-time.Minute)),
+		jobPod("execution-2-new", "execution-2", now),
+	)
+
+	tests := []struct {
+		name    string
+		input   model.PipelineExecutionLogsInput
+		want    string
+		wantErr bool
+	}{
+		{name: "last attempt", input: model.PipelineExecutionLogsInput{ID: "execution"}, want: "execution-2-new"},
+		{name: "first attempt", input: model.PipelineExecutionLogsInput{ID: "execution", Attempt: intPtr(1)}, want: "execution-abcde"},
+		{name: "missing attempt", input: model.PipelineExecutionLogsInput{ID: "execution", Attempt: intPtr(3)}, wantErr: true},
+		{name: "not started", input: model.PipelineExecutionLogsInput{ID: "pending"}, wantErr: true},
+		{name: "missing execution", input: model.PipelineExecutionLogsInput{ID: "missing"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, pod, err := executionPod(ctx, tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("executionPod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if pod.Name != tt.want {
+				t.Errorf("executionPod() = %s, want %s", pod.Name, tt.want)
+			}
+		})
+	}
+
+	t.Run("attempt without a pod", func(t *testing.T) {
+		if _, _, err := executionPod(adminContext(execution), model.PipelineExecutionLogsInput{ID: "execution"}); err == nil {
+			t.Errorf("executionPod() error = nil, want an error")
+		}
+	})
+}
+
+func TestLogs(t *testing.T) {
+	execution := &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "execution", Namespace: "default"},
+		Status:     v1alpha1.PipelineExecutionStatus{Attempts: []v1alpha1.ExecutionAttempt{{Attempt: 1, JobName: "execution"}}},
+	}
+	ctx := adminContext(execution, jobPod("execution-abcde", "execution", time.Now()))
+
+	tests := []struct {
+		name    string
+		limit   *int
+		want    []string
+		wantErr bool
+	}{
+		// The fake clientset returns a single line for every pod.
+		{name: "default limit", want: []string{"fake logs"}},
+		{name: "limit", limit: intPtr(1), want: []string{"fake logs"}},
+		{name: "limit of zero", limit: intPtr(0), wantErr: true},
+		{name: "limit above the maximum", limit: intPtr(maxLogLines + 1), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Logs(ctx, model.PipelineExecutionLogsInput{ID: "execution", LimitLines: tt.limit})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Logs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Logs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogOptions(t *testing.T) {
+	got := logOptions(model.PipelineExecutionLogsInput{ID: "execution", TailLines: intPtr(10)}, true)
+	if got.Container != embedderContainer || !got.Follow || got.TailLines == nil || *got.TailLines != 10 {
+		t.Errorf("logOptions() = %+v, want the followed last 10 lines of the embedder", got)
+	}
+	if got := logOptions(model.PipelineExecutionLogsInput{ID: "execution"}, false); got.Follow || got.TailLines != nil {
+		t.Errorf("logOptions() = %+v, want all lines", got)
+	}
+}
+
+func TestLogScanner(t *testing.T) {
+	long := strings.Repeat("x", 100*1024)
+	scanner := logScanner(strings.NewReader("first\n" + long + "\nlast"))
+	var lines []string
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("logScanner() error = %v", err)
+	}
+	if want := []string{"first", long, "last"}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("logScanner() scanned %d lines, want %d", len(lines), len(want))
+	}
+}
+
+func TestStreamLogs(t *testing.T) {
+	execution := &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "execution", Namespace: "default"},
+		Status:     v1alpha1.PipelineExecutionStatus{Attempts: []v1alpha1.ExecutionAttempt{{Attempt: 1, JobName: "execution"}}},
+	}
+	ctx := adminContext(execution, jobPod("execution-abcde", "execution", time.Now()))
+
+	if _, err := StreamLogs(ctx, model.PipelineExecutionLogsInput{ID: "execution", LimitLines: intPtr(0)}); err == nil {
+		t.Errorf("StreamLogs() with a limit of zero error = nil, want an error")
+	}
+	if _, err := StreamLogs(ctx, model.PipelineExecutionLogsInput{ID: "missing"}); err == nil {
+		t.Errorf("StreamLogs() of a missing execution error = nil, want an error")
+	}
+
+	ch, err := StreamLogs(ctx, model.PipelineExecutionLogsInput{ID: "execution"})
+	if err != nil {
+		t.Fatalf("StreamLogs() error = %v", err)
+	}
+	var lines []string
+	for line := range ch {
+		if line.Error != nil {
+			t.Fatalf("StreamLogs() sent error %q", *line.Error)
+		}
+		lines = append(lines, *line.Text)
+	}
+	if want := []string{"fake logs"}; !reflect.DeepEqual(lines, want) {
+		t.Errorf("StreamLogs() sent %v, want %v", lines, want)
+	}
+}
+
+func TestStreamLogsError(t *testing.T) {
+	execution := &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: "execution", Namespace: "default"},
+		Status:     v1alpha1.PipelineExecutionStatus{Attempts: []v1alpha1.ExecutionAttempt{{Attempt: 1, JobName: "execution"}}},
+	}
+	pending := jobPod("execution-abcde", "execution", time.Now())
+	pending.Status.Phase = v1.PodPending
+	// The clientset doesn't know the pod, so polling it fails once the subscription started.
+	ctx := adminContext(execution, pending)
+
+	ch, err := StreamLogs(ctx, model.PipelineExecutionLogsInput{ID: "execution", Follow: boolPtr(true)})
+	if err != nil {
+		t.Fatalf("StreamLogs() error = %v", err)
+	}
+	var lines []*model.PipelineExecutionLogLine
+	for line := range ch {
+		lines = append(lines, line)
+	}
+	if len(lines) != 1 || lines[0].Text != nil || lines[0].Error == nil {
+		t.Fatalf("StreamLogs() sent %v, want a single error", lines)
+	}
+	if !strings.Contains(*lines[0].Error, "execution-abcde") {
+		t.Errorf("StreamLogs() error = %q, want the pod in it", *lines[0].Error)
+	}
+}
+
+func TestFollowPod(t *testing.T) {
+	clientset := kubefake.NewSimpleClientset()
+	running := jobPod("execution-abcde", "execution", time.Now())
+	running.Status.Phase = v1.PodRunning
+	stream, err := followPod(context.Background(), clientset, running, model.PipelineExecutionLogsInput{ID: "execution"})
+	if err != nil {
+		t.Fatalf("followPod() error = %v", err)
+	}
+	stream.Close()
+
+	// The pod is polled until it started or the subscription ended.
+	pending := jobPod("execution-abcde", "execution", time.Now())
+	pending.Status.Phase = v1.PodPending
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := followPod(ctx, clientset, pending, model.PipelineExecutionLogsInput{ID: "execution"}); err == nil {
+		t.Errorf("followPod() of an ended subscription error = nil, want an error")
+	}
+}
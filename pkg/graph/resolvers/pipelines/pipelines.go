@@ -3,11 +3,13 @@ package pipelines
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/encoder-run/operator/api/cloud/v1alpha1"
 	"github.com/encoder-run/operator/pkg/common"
 	"github.com/encoder-run/operator/pkg/graph/converters"
 	"github.com/encoder-run/operator/pkg/graph/model"
+	"github.com/encoder-run/operator/pkg/vectorstore"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -27,6 +29,26 @@ func Add(ctx context.Context, input model.AddPipelineInput) (*model.Pipeline, er
 		return nil, err
 	}
 
+	if pipelineCRD.Spec.RepositoryEmbeddings != nil {
+		// Get the model and the storage linked by the pipeline.
+		modelCRD := &v1alpha1.Model{}
+		if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: pipelineCRD.Spec.RepositoryEmbeddings.Model.Name}, modelCRD); err != nil {
+			return nil, err
+		}
+		storageCRD := &v1alpha1.Storage{}
+		if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: pipelineCRD.Spec.RepositoryEmbeddings.Storage.Name}, storageCRD); err != nil {
+			return nil, err
+		}
+
+		// The storage indices are created with the dimension of the model, so it is
+		// validated and persisted with the pipeline.
+		dimension := modelCRD.Spec.EmbeddingDimension()
+		if err := vectorstore.ValidateDimension(storageCRD.Spec.Type, dimension); err != nil {
+			return nil, err
+		}
+		pipelineCRD.Spec.RepositoryEmbeddings.Dimension = dimension
+	}
+
 	// Create the pipeline.
 	if err := ctrlClient.Create(ctx, pipelineCRD); err != nil {
 		return nil, err
@@ -119,6 +141,151 @@ func AddDeployment(ctx context.Context, input model.AddPipelineDeploymentInput)
 	return p, nil
 }
 
+func SetSchedule(ctx context.Context, input model.SetPipelineScheduleInput) (*model.Pipeline, error) {
+	// Get the controller-runtime client from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+
+	// Get the pipeline.
+	pipelineCRD := &v1alpha1.Pipeline{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: input.ID}, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	// Set the schedule, it is removed if not set.
+	pipelineCRD.Spec.Schedule = nil
+	if input.Schedule != nil {
+		schedule, err := converters.ScheduleInputToSpec(input.Schedule)
+		if err != nil {
+			return nil, err
+		}
+		pipelineCRD.Spec.Schedule = schedule
+	}
+
+	// Update the pipeline.
+	if err := ctrlClient.Update(ctx, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	// Convert the pipeline to the model.
+	p, err := converters.PipelineCRDToModel(pipelineCRD)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func SetConcurrencyPolicy(ctx context.Context, input model.SetPipelineConcurrencyPolicyInput) (*model.Pipeline, error) {
+	// Get the controller-runtime client from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+
+	// Get the pipeline.
+	pipelineCRD := &v1alpha1.Pipeline{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: input.ID}, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	// Set the concurrency policy, it applies to executions that were not started yet.
+	policy, err := converters.ConcurrencyPolicyToCRD(input.ConcurrencyPolicy)
+	if err != nil {
+		return nil, err
+	}
+	pipelineCRD.Spec.ConcurrencyPolicy = policy
+
+	// Update the pipeline.
+	if err := ctrlClient.Update(ctx, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	// Convert the pipeline to the model.
+	p, err := converters.PipelineCRDToModel(pipelineCRD)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func SetRetryPolicy(ctx context.Context, input model.SetPipelineRetryPolicyInput) (*model.Pipeline, error) {
+	// Get the controller-runtime client from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+
+	// Get the pipeline.
+	pipelineCRD := &v1alpha1.Pipeline{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: input.ID}, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	// Set the retry policy, the defaults are used if it is not set.
+	pipelineCRD.Spec.RetryPolicy = nil
+	if input.RetryPolicy != nil {
+		policy, err := converters.RetryPolicyInputToSpec(input.RetryPolicy)
+		if err != nil {
+			return nil, err
+		}
+		pipelineCRD.Spec.RetryPolicy = policy
+	}
+
+	// Update the pipeline.
+	if err := ctrlClient.Update(ctx, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	// Convert the pipeline to the model.
+	p, err := converters.PipelineCRDToModel(pipelineCRD)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func SetExecutionHistory(ctx context.Context, input model.SetPipelineExecutionHistoryInput) (*model.Pipeline, error) {
+	// Get the controller-runtime client from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+
+	// Get the pipeline.
+	pipelineCRD := &v1alpha1.Pipeline{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: input.ID}, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	// Set the execution history, the defaults are used if it is not set.
+	pipelineCRD.Spec.ExecutionHistory = nil
+	if input.ExecutionHistory != nil {
+		history, err := converters.ExecutionHistoryInputToSpec(input.ExecutionHistory)
+		if err != nil {
+			return nil, err
+		}
+		pipelineCRD.Spec.ExecutionHistory = history
+	}
+
+	// Update the pipeline.
+	if err := ctrlClient.Update(ctx, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	// Convert the pipeline to the model.
+	p, err := converters.PipelineCRDToModel(pipelineCRD)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
 func Trigger(ctx context.Context, id string) (*model.PipelineExecution, error) {
 	// Get the controller-runtime client from the context.
 	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
@@ -165,6 +332,102 @@ func Trigger(ctx context.Context, id string) (*model.PipelineExecution, error) {
 	return e, nil
 }
 
+// CancelExecution requests the pipeline execution to be stopped, the controller deletes
+// the job of its running attempt.
+func CancelExecution(ctx context.Context, id string) (*model.PipelineExecution, error) {
+	// Get the controller-runtime client from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+
+	// Get the pipeline execution.
+	executionCRD := &v1alpha1.PipelineExecution{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: id}, executionCRD); err != nil {
+		return nil, err
+	}
+	if executionCRD.Finished() {
+		return nil, fmt.Errorf("pipeline execution %s already finished", id)
+	}
+
+	patch := client.MergeFrom(executionCRD.DeepCopy())
+	executionCRD.Spec.Cancelled = true
+	if err := ctrlClient.Patch(ctx, executionCRD, patch); err != nil {
+		return nil, err
+	}
+
+	// Convert the pipeline execution to the model.
+	e, err := converters.PipelineExecutionCRDToModel(executionCRD)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// RetryExecution creates a new execution of the pipeline of a finished execution,
+// keeping its metadata. The retry indexes the current refs of the repository, not
+// the commits the retried execution was triggered for.
+func RetryExecution(ctx context.Context, id string) (*model.PipelineExecution, error) {
+	// Get the controller-runtime client from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+
+	// Get the pipeline execution and its pipeline.
+	retriedCRD := &v1alpha1.PipelineExecution{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: id}, retriedCRD); err != nil {
+		return nil, err
+	}
+	if !retriedCRD.Finished() {
+		return nil, fmt.Errorf("pipeline execution %s has not finished", id)
+	}
+	pipelineCRD := &v1alpha1.Pipeline{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: retriedCRD.Spec.PipelineRef.Name}, pipelineCRD); err != nil {
+		return nil, err
+	}
+
+	metadata := make(map[string]string, len(retriedCRD.Spec.Metadata)+1)
+	for k, v := range retriedCRD.Spec.Metadata {
+		metadata[k] = v
+	}
+	metadata["retryOf"] = id
+
+	// Create the pipeline execution.
+	executionCRD := &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "pipeline-execution-",
+			Namespace:    "default",
+			Labels: map[string]string{
+				"pipelineId": pipelineCRD.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(pipelineCRD, v1alpha1.GroupVersion.WithKind("Pipeline")),
+			},
+		},
+		Spec: v1alpha1.PipelineExecutionSpec{
+			PipelineRef: v1.ObjectReference{
+				Name:      pipelineCRD.Name,
+				Namespace: "default",
+			},
+			Metadata: metadata,
+		},
+	}
+
+	if err := ctrlClient.Create(ctx, executionCRD); err != nil {
+		return nil, err
+	}
+
+	// Convert the pipeline execution to the model.
+	e, err := converters.PipelineExecutionCRDToModel(executionCRD)
+	if err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
 func List(ctx context.Context) ([]*model.Pipeline, error) {
 	// Get the controller-runtime client from the context.
 	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
@@ -218,6 +481,63 @@ func Delete(ctx context.Context, id string) (*model.Pipeline, error) {
 	return p, nil
 }
 
+// executionPollInterval is the interval the execution of a subscription is fetched at.
+const executionPollInterval = 2 * time.Second
+
+// WatchExecution sends the pipeline execution whenever its resource version changes
+// and closes the channel once the execution finished or the subscription ended.
+func WatchExecution(ctx context.Context, id string) (<-chan *model.PipelineExecution, error) {
+	// Get the controller-runtime client from the context.
+	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("controller-runtime client not found in context")
+	}
+
+	// Fail the subscription right away if the execution doesn't exist.
+	executionCRD := &v1alpha1.PipelineExecution{}
+	if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: id}, executionCRD); err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *model.PipelineExecution, 1)
+	go func() {
+		defer close(ch)
+		ticker := time.NewTicker(executionPollInterval)
+		defer ticker.Stop()
+
+		var resourceVersion string
+		for {
+			if executionCRD.ResourceVersion != resourceVersion {
+				resourceVersion = executionCRD.ResourceVersion
+				e, err := converters.PipelineExecutionCRDToModel(executionCRD)
+				if err != nil {
+					return
+				}
+				select {
+				case ch <- e:
+				case <-ctx.Done():
+					return
+				}
+				if executionCRD.Finished() {
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+			executionCRD = &v1alpha1.PipelineExecution{}
+			// The subscription completes if the execution was deleted.
+			if err := ctrlClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: id}, executionCRD); err != nil {
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
 func Executions(ctx context.Context, id string) ([]*model.PipelineExecution, error) {
 	// Get the controller-runtime client from the context.
 	ctrlClient, ok := ctx.Value(common.AdminClientKey).(client.Client)
@@ -0,0 +1,95 @@
+package pipelines
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/encoder-run/operator/pkg/common"
+)
+
+// execution returns an execution of the pipeline in the state.
+func execution(name string, state v1alpha1.PipelineExecutionState) *v1alpha1.PipelineExecution {
+	pe := &v1alpha1.PipelineExecution{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: v1alpha1.PipelineExecutionSpec{
+			PipelineRef: v1.ObjectReference{Name: "pipeline", Namespace: "default"},
+			Metadata:    map[string]string{"ref": "refs/heads/main"},
+		},
+	}
+	if state != "" {
+		pe.Status.State = &state
+		if state != v1alpha1.PipelineExecutionStateActive {
+			completion := metav1.Now()
+			pe.Status.CompletionTime = &completion
+		}
+	}
+	return pe
+}
+
+func TestCancelExecution(t *testing.T) {
+	tests := []struct {
+		name    string
+		state   v1alpha1.PipelineExecutionState
+		wantErr bool
+	}{
+		{name: "pending", state: ""},
+		{name: "active", state: v1alpha1.PipelineExecutionStateActive},
+		{name: "succeeded", state: v1alpha1.PipelineExecutionStateSucceeded, wantErr: true},
+		{name: "cancelled", state: v1alpha1.PipelineExecutionStateCancelled, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := adminContext(execution("execution", tt.state))
+			_, err := CancelExecution(ctx, "execution")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CancelExecution() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			got := &v1alpha1.PipelineExecution{}
+			if err := ctx.Value(common.AdminClientKey).(client.Client).Get(ctx, client.ObjectKey{Namespace: "default", Name: "execution"}, got); err != nil {
+				t.Fatal(err)
+			}
+			if got.Spec.Cancelled == tt.wantErr {
+				t.Errorf("CancelExecution() cancelled = %v, want %v", got.Spec.Cancelled, !tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetryExecution(t *testing.T) {
+	pipeline := &v1alpha1.Pipeline{ObjectMeta: metav1.ObjectMeta{Name: "pipeline", Namespace: "default"}}
+	ctx := adminContext(pipeline,
+		execution("failed", v1alpha1.PipelineExecutionStateFailed),
+		execution("active", v1alpha1.PipelineExecutionStateActive),
+	)
+
+	if _, err := RetryExecution(ctx, "active"); err == nil {
+		t.Errorf("RetryExecution() of a running execution error = nil, want an error")
+	}
+	if _, err := RetryExecution(ctx, "missing"); err == nil {
+		t.Errorf("RetryExecution() of a missing execution error = nil, want an error")
+	}
+
+	e, err := RetryExecution(ctx, "failed")
+	if err != nil {
+		t.Fatalf("RetryExecution() error = %v", err)
+	}
+	got := &v1alpha1.PipelineExecution{}
+	if err := ctx.Value(common.AdminClientKey).(client.Client).Get(ctx, client.ObjectKey{Namespace: "default", Name: e.ID}, got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Spec.PipelineRef.Name != "pipeline" || got.Labels["pipelineId"] != "pipeline" {
+		t.Errorf("RetryExecution() created an execution of %s, want pipeline", got.Spec.PipelineRef.Name)
+	}
+	if want := map[string]string{"ref": "refs/heads/main", "retryOf": "failed"}; !reflect.DeepEqual(got.Spec.Metadata, want) {
+		t.Errorf("RetryExecution() metadata = %v, want %v", got.Spec.Metadata, want)
+	}
+	if len(got.OwnerReferences) != 1 || got.OwnerReferences[0].Name != "pipeline" {
+		t.Errorf("RetryExecution() owners = %v, want the pipeline", got.OwnerReferences)
+	}
+}
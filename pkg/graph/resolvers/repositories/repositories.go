@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/encoder-run/operator/api/cloud/v1alpha1"
 	"github.com/encoder-run/operator/pkg/common"
@@ -107,95 +108,234 @@ func add(ctx context.Context, c client.Client, input *model.AddRepositoryInput)
 	if input.Token == nil {
 		return nil, fmt.Errorf("token cannot be empty")
 	}
-	if input.Branch == nil {
-		return nil, fmt.Errorf("branch cannot be empty")
+	if input.Branch == nil && len(input.Refs) == 0 {
+		return nil, fmt.Errorf("branch or refs cannot be empty")
 	}
-	// Build the url based on the type, owner, and name.
-	url := converters.RepositoryURL(*input.Type, *input.Owner, *input.Name)
-	if url == "" {
-		return nil, fmt.Errorf("failed to build repository URL")
+	var branch string
+	if input.Branch != nil {
+		branch = *input.Branch
+	}
+
+	repo := &v1alpha1.Repository{
+		ObjectMeta: v1.ObjectMeta{
+			GenerateName: "repo-",
+			Namespace:    "default",
+		},
 	}
 
 	switch *input.Type {
 	case model.RepositoryTypeGithub:
-		// Create the repo
-		repo := &v1alpha1.Repository{
-			ObjectMeta: v1.ObjectMeta{
-				GenerateName: "repo-",
-				Namespace:    "default",
-			},
-			Spec: v1alpha1.RepositorySpec{
-				Type: v1alpha1.RepositoryTypeGithub,
-				Github: &v1alpha1.GithubRepositorySpec{
-					URL:    url,
-					Owner:  *input.Owner,
-					Name:   *input.Name,
-					Branch: *input.Branch,
-				},
+		// Build the url based on the type, owner, and name.
+		url := converters.RepositoryURL(*input.Type, *input.Owner, *input.Name)
+		if url == "" {
+			return nil, fmt.Errorf("failed to build repository URL")
+		}
+		repo.Spec = v1alpha1.RepositorySpec{
+			Type: v1alpha1.RepositoryTypeGithub,
+			Github: &v1alpha1.GithubRepositorySpec{
+				URL:    url,
+				Owner:  *input.Owner,
+				Name:   *input.Name,
+				Branch: branch,
 			},
 		}
-		if err := c.Create(ctx, repo); err != nil {
+	case model.RepositoryTypeGitlab:
+		baseURL := converters.DefaultGitlabBaseURL
+		if input.BaseURL != nil && *input.BaseURL != "" {
+			baseURL = strings.TrimSuffix(*input.BaseURL, "/")
+		}
+		projectPath := fmt.Sprintf("%s/%s", *input.Owner, *input.Name)
+		url, err := converters.GitlabRepositoryURL(baseURL, projectPath)
+		if err != nil {
 			return nil, err
 		}
-
-		// Create the secret with the name of the repository.
-		secret := &corev1.Secret{
-			ObjectMeta: v1.ObjectMeta{
-				Name:      repo.Name,
-				Namespace: repo.Namespace,
-			},
-			Data: map[string][]byte{
-				"token": []byte(*input.Token),
+		repo.Spec = v1alpha1.RepositorySpec{
+			Type: v1alpha1.RepositoryTypeGitlab,
+			Gitlab: &v1alpha1.GitlabRepositorySpec{
+				BaseURL:     baseURL,
+				ProjectPath: projectPath,
+				URL:         url,
+				Branch:      branch,
 			},
 		}
-
-		if err := c.Create(ctx, secret); err != nil {
-			return nil, err
+	case model.RepositoryTypeBitbucket:
+		repo.Spec = v1alpha1.RepositorySpec{
+			Type: v1alpha1.RepositoryTypeBitbucket,
+			Bitbucket: &v1alpha1.BitbucketRepositorySpec{
+				URL:       converters.RepositoryURL(*input.Type, *input.Owner, *input.Name),
+				Workspace: *input.Owner,
+				Name:      *input.Name,
+				Branch:    branch,
+			},
 		}
-
-		return converters.RepositoryCRDToModel(repo)
 	default:
 		return nil, fmt.Errorf("unsupported repository type: %s", *input.Type)
 	}
+
+	return create(ctx, c, repo, input)
 }
 
 func addURL(ctx context.Context, c client.Client, input *model.AddRepositoryInput) (*model.Repository, error) {
 	if *input.URL == "" {
 		return nil, fmt.Errorf("URL cannot be empty")
 	}
-	// Split repository URL to get the type, owner and name.
-	repoType, owner, name, err := converters.SplitRepositoryURL(*input.URL)
+	// Any git remote is cloned as is.
+	if input.Type != nil && *input.Type == model.RepositoryTypeGit {
+		return addGit(ctx, c, input)
+	}
+	// Split repository URL to get the type, owner and name. Self-managed Gitlab
+	// instances can't be detected from the host so the type is taken from the input.
+	var repoType v1alpha1.RepositoryType
+	var baseURL, owner, name string
+	var err error
+	if input.Type != nil && *input.Type == model.RepositoryTypeGitlab {
+		repoType, baseURL, owner, name, err = converters.SplitGitlabURL(*input.URL)
+	} else {
+		repoType, baseURL, owner, name, err = converters.SplitRepositoryURL(*input.URL)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	// Strip .git from the url
-	url := *input.URL
-	if url[len(url)-4:] == ".git" {
-		url = url[:len(url)-4]
+	var branch string
+	if input.Branch != nil {
+		branch = *input.Branch
+	}
+
+	repo := &v1alpha1.Repository{
+		ObjectMeta: v1.ObjectMeta{
+			GenerateName: "repo-",
+			Namespace:    "default",
+		},
 	}
 
 	switch repoType {
 	case v1alpha1.RepositoryTypeGithub:
-		repo := &v1alpha1.Repository{
-			ObjectMeta: v1.ObjectMeta{
-				GenerateName: "repo-",
-				Namespace:    "default",
-			},
-			Spec: v1alpha1.RepositorySpec{
-				Type: repoType,
-				Github: &v1alpha1.GithubRepositorySpec{
-					URL:   url,
-					Owner: owner,
-					Name:  name,
-				},
+		repo.Spec = v1alpha1.RepositorySpec{
+			Type: repoType,
+			Github: &v1alpha1.GithubRepositorySpec{
+				URL:    converters.RepositoryURL(model.RepositoryTypeGithub, owner, name),
+				Owner:  owner,
+				Name:   name,
+				Branch: branch,
 			},
 		}
-		if err := c.Create(ctx, repo); err != nil {
+	case v1alpha1.RepositoryTypeGitlab:
+		projectPath := fmt.Sprintf("%s/%s", owner, name)
+		url, err := converters.GitlabRepositoryURL(baseURL, projectPath)
+		if err != nil {
 			return nil, err
 		}
-		return converters.RepositoryCRDToModel(repo)
+		repo.Spec = v1alpha1.RepositorySpec{
+			Type: repoType,
+			Gitlab: &v1alpha1.GitlabRepositorySpec{
+				BaseURL:     baseURL,
+				ProjectPath: projectPath,
+				URL:         url,
+				Branch:      branch,
+			},
+		}
+	case v1alpha1.RepositoryTypeBitbucket:
+		repo.Spec = v1alpha1.RepositorySpec{
+			Type: repoType,
+			Bitbucket: &v1alpha1.BitbucketRepositorySpec{
+				URL:       converters.RepositoryURL(model.RepositoryTypeBitbucket, owner, name),
+				Workspace: owner,
+				Name:      name,
+				Branch:    branch,
+			},
+		}
 	default:
 		return nil, fmt.Errorf("unsupported repository type: %s", repoType)
 	}
+
+	return create(ctx, c, repo, input)
+}
+
+func addGit(ctx context.Context, c client.Client, input *model.AddRepositoryInput) (*model.Repository, error) {
+	url, err := converters.GitRepositoryURL(*input.URL)
+	if err != nil {
+		return nil, err
+	}
+	if input.SSHPrivateKey != nil && *input.SSHPrivateKey != "" && (input.KnownHosts == nil || *input.KnownHosts == "") {
+		return nil, fmt.Errorf("known hosts cannot be empty when an ssh private key is provided")
+	}
+
+	var branch string
+	if input.Branch != nil {
+		branch = *input.Branch
+	}
+
+	repo := &v1alpha1.Repository{
+		ObjectMeta: v1.ObjectMeta{
+			GenerateName: "repo-",
+			Namespace:    "default",
+		},
+		Spec: v1alpha1.RepositorySpec{
+			Type: v1alpha1.RepositoryTypeGit,
+			Git: &v1alpha1.GitRepositorySpec{
+				CloneURL: strings.TrimSpace(*input.URL),
+				URL:      url,
+				Branch:   branch,
+			},
+		},
+	}
+
+	return create(ctx, c, repo, input)
+}
+
+// create creates the repository and, if credentials are provided, the secret holding them.
+func create(ctx context.Context, c client.Client, repo *v1alpha1.Repository, input *model.AddRepositoryInput) (*model.Repository, error) {
+	for _, ref := range input.Refs {
+		if strings.TrimSpace(ref) == "" {
+			return nil, fmt.Errorf("refs cannot be empty")
+		}
+	}
+	repo.Spec.Refs = input.Refs
+	if err := c.Create(ctx, repo); err != nil {
+		return nil, err
+	}
+
+	data := map[string][]byte{}
+	if input.Token != nil && *input.Token != "" {
+		data["token"] = []byte(*input.Token)
+		// Bitbucket app passwords and http git remotes use the token as the password of the user.
+		usesPassword := repo.Spec.Type == v1alpha1.RepositoryTypeBitbucket || repo.Spec.Type == v1alpha1.RepositoryTypeGit
+		if usesPassword && input.Username != nil && *input.Username != "" {
+			data = map[string][]byte{
+				"username": []byte(*input.Username),
+				"password": []byte(*input.Token),
+			}
+		}
+	}
+	if input.SSHPrivateKey != nil && *input.SSHPrivateKey != "" {
+		data[corev1.SSHAuthPrivateKey] = []byte(*input.SSHPrivateKey)
+		if input.SSHPassphrase != nil && *input.SSHPassphrase != "" {
+			data["passphrase"] = []byte(*input.SSHPassphrase)
+		}
+	}
+	if input.KnownHosts != nil && *input.KnownHosts != "" {
+		data["known_hosts"] = []byte(*input.KnownHosts)
+	}
+	if input.WebhookSecret != nil && *input.WebhookSecret != "" {
+		data[v1alpha1.WebhookSecretKey] = []byte(*input.WebhookSecret)
+	}
+	if len(data) == 0 {
+		return converters.RepositoryCRDToModel(repo)
+	}
+
+	// Create the secret with the name of the repository.
+	secret := &corev1.Secret{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      repo.Name,
+			Namespace: repo.Namespace,
+		},
+		Data: data,
+	}
+
+	if err := c.Create(ctx, secret); err != nil {
+		return nil, err
+	}
+
+	return converters.RepositoryCRDToModel(repo)
 }
@@ -1,26 +1,17 @@
 package search
 
 import (
-	"bytes"
 	"context"
-	"encoding/binary"
-	"errors"
 	"fmt"
-	"log"
 	"sort"
+	"strings"
 
-	"github.com/RediSearch/redisearch-go/v2/redisearch"
 	"github.com/encoder-run/operator/api/cloud/v1alpha1"
 	"github.com/encoder-run/operator/pkg/common"
-	"github.com/encoder-run/operator/pkg/database"
 	"github.com/encoder-run/operator/pkg/embedder"
 	"github.com/encoder-run/operator/pkg/graph/converters"
 	"github.com/encoder-run/operator/pkg/graph/model"
-	redigoredis "github.com/gomodule/redigo/redis"
-	"github.com/pgvector/pgvector-go"
-	"github.com/redis/go-redis/v9"
-	"gorm.io/gorm/clause"
-	corev1 "k8s.io/api/core/v1"
+	"github.com/encoder-run/operator/pkg/vectorstore"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -48,29 +39,25 @@ func Semantic(ctx context.Context, query model.QueryInput) ([]*model.SearchResul
 			continue
 		}
 
+		// Only pipelines with the snapshot are searched.
+		var snapshot *v1alpha1.IndexedCommit
+		if query.Snapshot != nil && *query.Snapshot != "" {
+			if snapshot = findSnapshot(&pipeline, *query.Snapshot); snapshot == nil {
+				continue
+			}
+		}
+
 		// Get the storage.
 		storageCRD := &v1alpha1.Storage{}
 		if err := ctrlClient.Get(ctx, client.ObjectKey{Name: pipeline.Spec.RepositoryEmbeddings.Storage.Name, Namespace: pipeline.Namespace}, storageCRD); err != nil {
 			return nil, err
 		}
 
-		switch storageCRD.Spec.Type {
-		case v1alpha1.StorageTypeRedis:
-			rs, err := semanticSearchRedis(ctrlClient, &pipeline, storageCRD, &query)
-			if err != nil {
-				return nil, err
-			}
-			results = append(results, rs...)
-		case v1alpha1.StorageTypePostgres:
-			rs, err := semanticSearchPostgres(ctrlClient, &pipeline, storageCRD, &query)
-			if err != nil {
-				return nil, err
-			}
-			results = append(results, rs...)
-		default:
-			return nil, fmt.Errorf("unsupported storage type: %s", storageCRD.Spec.Type)
+		rs, err := semanticSearch(ctx, ctrlClient, &pipeline, storageCRD, &query, snapshot)
+		if err != nil {
+			return nil, err
 		}
-
+		results = append(results, rs...)
 	}
 	// Sort based on score
 	sort.Slice(results, func(i, j int) bool {
@@ -79,75 +66,35 @@ func Semantic(ctx context.Context, query model.QueryInput) ([]*model.SearchResul
 	return results, nil
 }
 
-func semanticSearchPostgres(ctrlClient client.Client, pipeline *v1alpha1.Pipeline, storage *v1alpha1.Storage, query *model.QueryInput) ([]*model.SearchResult, error) {
+func semanticSearch(ctx context.Context, ctrlClient client.Client, pipeline *v1alpha1.Pipeline, storage *v1alpha1.Storage, query *model.QueryInput, snapshot *v1alpha1.IndexedCommit) ([]*model.SearchResult, error) {
 	// get repository object
 	repository := v1alpha1.Repository{}
-	if err := ctrlClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Spec.RepositoryEmbeddings.Repository.Name, Namespace: pipeline.Namespace}, &repository); err != nil {
+	if err := ctrlClient.Get(ctx, types.NamespacedName{Name: pipeline.Spec.RepositoryEmbeddings.Repository.Name, Namespace: pipeline.Namespace}, &repository); err != nil {
 		return nil, err
 	}
 
-	if repository.Spec.Type != v1alpha1.RepositoryTypeGithub {
-		return nil, fmt.Errorf("unsupported repository type: %s", repository.Spec.Type)
-	}
-	// get the password from the postgres secret
-	secret := &corev1.Secret{}
-	if err := ctrlClient.Get(context.TODO(), client.ObjectKey{Name: storage.Name, Namespace: storage.Namespace}, secret); err != nil {
+	url, err := converters.RepositoryCRDURL(&repository)
+	if err != nil {
 		return nil, err
 	}
 
-	hostBytes, ok := secret.Data["host"]
-	if !ok {
-		return nil, errors.New("host not found in secret")
-	}
-	host := string(hostBytes)
-
-	usernameBytes, ok := secret.Data["username"]
-	if !ok {
-		return nil, errors.New("username not found in secret")
-	}
-	username := string(usernameBytes)
-
-	passwordBytes, ok := secret.Data["password"]
-	if !ok {
-		return nil, errors.New("password not found in secret")
-	}
-	password := string(passwordBytes)
-
-	databaseBytes, ok := secret.Data["database"]
-	if !ok {
-		return nil, errors.New("database not found in secret")
-	}
-	db := string(databaseBytes)
-
-	portBytes, ok := secret.Data["port"]
-	if !ok {
-		return nil, errors.New("port not found in secret")
-	}
-	port := string(portBytes)
-
-	sslModeBytes, ok := secret.Data["ssl_mode"]
-	if !ok {
-		return nil, errors.New("ssl_mode not found in secret")
+	store, err := vectorstore.New(ctx, ctrlClient, storage, url, pipeline.Spec.RepositoryEmbeddings.EmbeddingDimension())
+	if err != nil {
+		return nil, err
 	}
-	sslMode := string(sslModeBytes)
+	defer store.Close()
 
-	timezoneBytes, ok := secret.Data["timezone"]
-	if !ok {
-		return nil, errors.New("timezone not found in secret")
+	// get model object
+	modelCRD := v1alpha1.Model{}
+	if err := ctrlClient.Get(ctx, types.NamespacedName{Name: pipeline.Spec.RepositoryEmbeddings.Model.Name, Namespace: pipeline.Namespace}, &modelCRD); err != nil {
+		return nil, err
 	}
-	timezone := string(timezoneBytes)
 
-	// Construct the DSN
-
-	dsn := database.ConstructPostgresDSN(host, username, password, db, port, sslMode, timezone)
-	dbClient, err := database.GetPostgresClient(dsn)
+	// search the model with the query
+	c, err := embedder.NewModelClient(ctx, ctrlClient, &modelCRD)
 	if err != nil {
 		return nil, err
 	}
-
-	modelId := pipeline.Spec.RepositoryEmbeddings.Model.Name
-	// search the model with the query
-	c := embedder.NewClient(modelId, pipeline.Namespace)
 	response, err := c.FetchEmbeddings([]embedder.CodeEmbeddingRequest{
 		{
 			Path:    "/",
@@ -173,31 +120,42 @@ func semanticSearchPostgres(ctrlClient client.Client, pipeline *v1alpha1.Pipelin
 
 	emb := codeEmb.Embeddings[0].Embedding
 
-	codeEmbeddings := make([]database.CodeEmbedding, 0, len(codeEmb.Embeddings))
-
-	dbClient.Clauses(clause.OrderBy{
-		Expression: clause.Expr{
-			SQL:  "embedding <=> ? ASC", // Ensure sorting by ascending order of cosine distances
-			Vars: []interface{}{pgvector.NewVector(emb)},
-		},
-	}).Limit(25).Find(&codeEmbeddings)
+	refs := queryRefs(query.Ref)
+	if snapshot != nil {
+		refs = []string{vectorstore.SnapshotRef(snapshot.Commit)}
+	} else if refs == nil && pipeline.Spec.RepositoryEmbeddings.Snapshots > 0 {
+		// Files only in older snapshots are not searched by default.
+		for _, c := range pipeline.Status.IndexedCommits {
+			refs = append(refs, c.Ref)
+		}
+	}
+	matches, err := store.Query(ctx, emb, 25, refs)
+	if err != nil {
+		return nil, err
+	}
 
-	results := make([]*model.SearchResult, 0, len(codeEmbeddings))
-	for _, ce := range codeEmbeddings {
-		sr := converters.CodeEmbeddingToSearchResult(&ce, &repository)
+	results := make([]*model.SearchResult, 0, len(matches))
+	for _, m := range matches {
+		sr, err := converters.MatchToSearchResult(&m, &repository)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot != nil {
+			sr.Commit = &snapshot.Commit
+		} else {
+			sr.Commit = refCommit(pipeline, m.Refs)
+		}
 		results = append(results, sr)
 	}
 
 	// Get the file content for the search results
 	for _, sr := range results {
-		// Get the file content from postgres
-		object := database.Object{}
-		// Select by hash, blob type, and url
-		if err := dbClient.Where("hash = ? AND type = ? AND url = ?", sr.Hash, "blob", repository.Spec.Github.URL).First(&object).Error; err != nil {
-			return nil, err
+		content, err := store.Blob(ctx, sr.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get blob %s: %w", sr.Hash, err)
 		}
 
-		adjustedContent, startLine, err := extractContentWindowIndex(string(object.Blob), sr.StartIndex, sr.EndIndex)
+		adjustedContent, startLine, err := extractContentWindowIndex(string(content), sr.StartIndex, sr.EndIndex)
 		if err != nil {
 			return nil, fmt.Errorf("failed to extract content window from file hash %s: %w", sr.Hash, err)
 		}
@@ -208,97 +166,39 @@ func semanticSearchPostgres(ctrlClient client.Client, pipeline *v1alpha1.Pipelin
 	return results, nil
 }
 
-func semanticSearchRedis(ctrlClient client.Client, pipeline *v1alpha1.Pipeline, storage *v1alpha1.Storage, query *model.QueryInput) ([]*model.SearchResult, error) {
-	// get repository object
-	repository := v1alpha1.Repository{}
-	if err := ctrlClient.Get(context.Background(), types.NamespacedName{Name: pipeline.Spec.RepositoryEmbeddings.Repository.Name, Namespace: pipeline.Namespace}, &repository); err != nil {
-		return nil, err
+// queryRefs returns the full names of the refs to search. Names without the refs/
+// prefix match both the branch and the tag of the name.
+func queryRefs(ref *string) []string {
+	if ref == nil || *ref == "" {
+		return nil
 	}
-
-	if repository.Spec.Type != v1alpha1.RepositoryTypeGithub {
-		return nil, fmt.Errorf("unsupported repository type: %s", repository.Spec.Type)
-	}
-
-	redisearchClient, err := getSearchClient(ctrlClient, storage, repository.Spec.Github.URL)
-	if err != nil {
-		return nil, err
-	}
-	redisClient, err := getRedisClient(ctrlClient, storage)
-	if err != nil {
-		return nil, err
-	}
-	modelId := pipeline.Spec.RepositoryEmbeddings.Model.Name
-	// search the model with the query
-	c := embedder.NewClient(modelId, pipeline.Namespace)
-	response, err := c.FetchEmbeddings([]embedder.CodeEmbeddingRequest{
-		{
-			Path:    "/",
-			Content: query.Query,
-			Hash:    "query",
-		},
-	})
-	if err != nil {
-		return nil, err
-	}
-	if len(response.Results) == 0 {
-		return nil, fmt.Errorf("no results returned from the model")
-	}
-
-	codeEmb, ok := response.Results["/"]
-	if !ok {
-		return nil, fmt.Errorf("no embeddings returned for the query")
-	}
-
-	if len(codeEmb.Embeddings) == 0 {
-		return nil, fmt.Errorf("no embeddings returned for the query")
-	}
-
-	emb := codeEmb.Embeddings[0].Embedding
-
-	// Query vector represented as blob
-	queryBlob := convertToBlob(emb)
-
-	// Set up KNN search
-	knnQuery := fmt.Sprintf("*=>[KNN %d @embedding $B AS __vec_score]", 25)
-
-	redisQuery := redisearch.NewQuery(knnQuery).
-		SetParams(map[string]interface{}{"B": queryBlob}).
-		SetSortBy("__vec_score", true). // Sort by the vector score
-		AddReturnFields("__vec_score", "chunkID", "fileHash", "filePath", "startIndex", "endIndex").
-		SetDialect(2).
-		Limit(0, 25)
-
-	docs, _, err := redisearchClient.Search(redisQuery)
-	if err != nil {
-		return nil, fmt.Errorf("failed to search documents: %w", err)
+	if strings.HasPrefix(*ref, "refs/") {
+		return []string{*ref}
 	}
+	return []string{"refs/heads/" + *ref, "refs/tags/" + *ref}
+}
 
-	results := make([]*model.SearchResult, 0, len(docs))
-	for _, doc := range docs {
-		sr, err := converters.RedisEmbeddingDocToSearchResult(&doc, &repository)
-		if err != nil {
-			return nil, err
+// findSnapshot returns the snapshot of the pipeline whose commit starts with the hash.
+func findSnapshot(pipeline *v1alpha1.Pipeline, hash string) *v1alpha1.IndexedCommit {
+	for i, s := range pipeline.Status.Snapshots {
+		if strings.HasPrefix(s.Commit, hash) {
+			return &pipeline.Status.Snapshots[i]
 		}
-		results = append(results, sr)
 	}
+	return nil
+}
 
-	// Get the file content for the search results
-	for _, sr := range results {
-		// Get the file content
-		key := fmt.Sprintf("%s:%s:%s:%s", repository.Spec.Github.URL, "object", "blob", sr.Hash)
-		content, err := redisClient.Get(context.Background(), key).Result()
-		if err != nil {
-			return nil, err
-		}
-		adjustedContent, startLine, err := extractContentWindowIndex(content, sr.StartIndex, sr.EndIndex)
-		if err != nil {
-			return nil, fmt.Errorf("failed to extract content window from file key %s: %w", key, err)
+// refCommit returns the indexed commit of the first of the refs, snapshot refs are
+// skipped.
+func refCommit(pipeline *v1alpha1.Pipeline, refs []string) *string {
+	for _, ref := range refs {
+		for i, c := range pipeline.Status.IndexedCommits {
+			if c.Ref == ref {
+				return &pipeline.Status.IndexedCommits[i].Commit
+			}
 		}
-		sr.Content = adjustedContent
-		sr.StartLine = startLine
 	}
-
-	return results, nil
+	return nil
 }
 
 func extractContentWindowIndex(content string, startIndex int, endIndex int) (string, int, error) {
@@ -319,62 +219,3 @@ func extractContentWindowIndex(content string, startIndex int, endIndex int) (st
 
 	return content[startIndex:endIndex], startLine, nil
 }
-func getRedisClient(k8sClient client.Client, storage *v1alpha1.Storage) (*redis.Client, error) {
-	// Retrieve the secret containing the Redis password
-	secret := &corev1.Secret{}
-	err := k8sClient.Get(context.Background(), types.NamespacedName{Name: storage.Name, Namespace: storage.Namespace}, secret)
-	if err != nil {
-		return nil, err
-	}
-
-	passwordBytes, ok := secret.Data["password"]
-	if !ok {
-		return nil, errors.New("password not found in secret")
-	}
-
-	password := string(passwordBytes)
-	host := common.RedisServiceURL(storage.Name, storage.Namespace)
-
-	c := redis.NewClient(&redis.Options{
-		Addr:     host,
-		Password: password,
-		DB:       0,
-	})
-
-	return c, nil
-}
-
-func getSearchClient(k8sClient client.Client, storage *v1alpha1.Storage, ns string) (*redisearch.Client, error) {
-
-	// Retrieve the secret containing the Redis password
-	secret := &corev1.Secret{}
-	err := k8sClient.Get(context.Background(), types.NamespacedName{Name: storage.Name, Namespace: storage.Namespace}, secret)
-	if err != nil {
-		return nil, err
-	}
-
-	passwordBytes, ok := secret.Data["password"]
-	if !ok {
-		return nil, errors.New("password not found in secret")
-	}
-
-	password := string(passwordBytes)
-	host := common.RedisServiceURL(storage.Name, storage.Namespace)
-
-	pool := &redigoredis.Pool{Dial: func() (redigoredis.Conn, error) {
-		return redigoredis.Dial("tcp", host, redigoredis.DialPassword(password))
-	}}
-
-	c := redisearch.NewClientFromPool(pool, fmt.Sprintf("%s:%s", ns, "embedding"))
-
-	return c, nil
-}
-
-func convertToBlob(vector []float32) []byte {
-	buf := new(bytes.Buffer)
-	err := binary.Write(buf, binary.LittleEndian, vector)
-	if err != nil {
-		log.Fatal("Failed to convert vector to blob: ", err)
-	}
-	return buf.Bytes()
-}
@@ -65,11 +65,41 @@ func (r *mutationResolver) AddPipelineDeployment(ctx context.Context, input mode
 	return pipelines.AddDeployment(ctx, input)
 }
 
+// SetPipelineSchedule is the resolver for the setPipelineSchedule field.
+func (r *mutationResolver) SetPipelineSchedule(ctx context.Context, input model.SetPipelineScheduleInput) (*model.Pipeline, error) {
+	return pipelines.SetSchedule(ctx, input)
+}
+
+// SetPipelineConcurrencyPolicy is the resolver for the setPipelineConcurrencyPolicy field.
+func (r *mutationResolver) SetPipelineConcurrencyPolicy(ctx context.Context, input model.SetPipelineConcurrencyPolicyInput) (*model.Pipeline, error) {
+	return pipelines.SetConcurrencyPolicy(ctx, input)
+}
+
+// SetPipelineRetryPolicy is the resolver for the setPipelineRetryPolicy field.
+func (r *mutationResolver) SetPipelineRetryPolicy(ctx context.Context, input model.SetPipelineRetryPolicyInput) (*model.Pipeline, error) {
+	return pipelines.SetRetryPolicy(ctx, input)
+}
+
+// SetPipelineExecutionHistory is the resolver for the setPipelineExecutionHistory field.
+func (r *mutationResolver) SetPipelineExecutionHistory(ctx context.Context, input model.SetPipelineExecutionHistoryInput) (*model.Pipeline, error) {
+	return pipelines.SetExecutionHistory(ctx, input)
+}
+
 // TriggerPipeline is the resolver for the triggerPipeline field.
 func (r *mutationResolver) TriggerPipeline(ctx context.Context, id string) (*model.PipelineExecution, error) {
 	return pipelines.Trigger(ctx, id)
 }
 
+// CancelPipelineExecution is the resolver for the cancelPipelineExecution field.
+func (r *mutationResolver) CancelPipelineExecution(ctx context.Context, id string) (*model.PipelineExecution, error) {
+	return pipelines.CancelExecution(ctx, id)
+}
+
+// RetryPipelineExecution is the resolver for the retryPipelineExecution field.
+func (r *mutationResolver) RetryPipelineExecution(ctx context.Context, id string) (*model.PipelineExecution, error) {
+	return pipelines.RetryExecution(ctx, id)
+}
+
 // DeletePipeline is the resolver for the deletePipeline field.
 func (r *mutationResolver) DeletePipeline(ctx context.Context, id string) (*model.Pipeline, error) {
 	return pipelines.Delete(ctx, id)
@@ -120,16 +150,40 @@ func (r *queryResolver) GetPipelineExecutions(ctx context.Context, id string) ([
 	return pipelines.Executions(ctx, id)
 }
 
+// PipelineExecutions is the resolver for the pipelineExecutions field.
+func (r *queryResolver) PipelineExecutions(ctx context.Context, input model.PipelineExecutionsInput) (*model.PipelineExecutionConnection, error) {
+	return pipelines.ExecutionsPage(ctx, input)
+}
+
+// PipelineExecutionLogs is the resolver for the pipelineExecutionLogs field.
+func (r *queryResolver) PipelineExecutionLogs(ctx context.Context, input model.PipelineExecutionLogsInput) ([]string, error) {
+	return pipelines.Logs(ctx, input)
+}
+
 // SemanticSearch is the resolver for the semanticSearch field.
 func (r *queryResolver) SemanticSearch(ctx context.Context, query model.QueryInput) ([]*model.SearchResult, error) {
 	return search.Semantic(ctx, query)
 }
 
+// PipelineExecution is the resolver for the pipelineExecution field.
+func (r *subscriptionResolver) PipelineExecution(ctx context.Context, id string) (<-chan *model.PipelineExecution, error) {
+	return pipelines.WatchExecution(ctx, id)
+}
+
+// PipelineExecutionLogs is the resolver for the pipelineExecutionLogs field.
+func (r *subscriptionResolver) PipelineExecutionLogs(ctx context.Context, input model.PipelineExecutionLogsInput) (<-chan *model.PipelineExecutionLogLine, error) {
+	return pipelines.StreamLogs(ctx, input)
+}
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
@@ -0,0 +1,29 @@
+package schedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/robfig/cron/v3"
+)
+
+// Parse parses the cron expression of the schedule of a pipeline in its time zone.
+func Parse(spec *v1alpha1.ScheduleSpec) (cron.Schedule, error) {
+	expr := spec.Cron
+	if spec.TimeZone != nil && *spec.TimeZone != "" {
+		if strings.Contains(expr, "TZ=") {
+			return nil, fmt.Errorf("the cron expression must not set a time zone when timeZone is set")
+		}
+		if _, err := time.LoadLocation(*spec.TimeZone); err != nil {
+			return nil, fmt.Errorf("unknown time zone %q: %w", *spec.TimeZone, err)
+		}
+		expr = fmt.Sprintf("CRON_TZ=%s %s", *spec.TimeZone, expr)
+	}
+	s, err := cron.ParseStandard(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression %q: %w", spec.Cron, err)
+	}
+	return s, nil
+}
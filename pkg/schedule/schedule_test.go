@@ -0,0 +1,102 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+func TestParse(t *testing.T) {
+	berlin := "Europe/Berlin"
+	unknown := "Mars/Olympus_Mons"
+	empty := ""
+	from := time.Date(2024, time.March, 10, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		cron     string
+		timeZone *string
+		// after defaults to from.
+		after   time.Time
+		next    time.Time
+		wantErr bool
+	}{
+		{
+			name: "standard expression in UTC",
+			cron: "0 * * * *",
+			next: time.Date(2024, time.March, 10, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "descriptor",
+			cron: "@daily",
+			next: time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "time zone of the schedule",
+			cron:     "0 3 * * *",
+			timeZone: &berlin,
+			// 03:00 CET is 02:00 UTC.
+			next: time.Date(2024, time.March, 11, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "time zone across a daylight saving change",
+			cron:     "0 3 * * *",
+			timeZone: &berlin,
+			after:    time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC),
+			// 03:00 CEST is 01:00 UTC after the clocks moved forward on March 31.
+			next: time.Date(2024, time.April, 1, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "CRON_TZ in the expression",
+			cron: "CRON_TZ=Europe/Berlin 0 3 * * *",
+			next: time.Date(2024, time.March, 11, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "empty time zone",
+			cron:     "30 12 * * *",
+			timeZone: &empty,
+			next:     time.Date(2024, time.March, 11, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "CRON_TZ in the expression and the time zone",
+			cron:     "CRON_TZ=Europe/Berlin 0 3 * * *",
+			timeZone: &berlin,
+			wantErr:  true,
+		},
+		{
+			name:     "unknown time zone",
+			cron:     "0 3 * * *",
+			timeZone: &unknown,
+			wantErr:  true,
+		},
+		{
+			name:    "invalid expression",
+			cron:    "0 3 * *",
+			wantErr: true,
+		},
+		{
+			name:    "seconds are not supported",
+			cron:    "0 0 3 * * *",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sched, err := Parse(&v1alpha1.ScheduleSpec{Cron: tt.cron, TimeZone: tt.timeZone})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			after := tt.after
+			if after.IsZero() {
+				after = from
+			}
+			if got := sched.Next(after); !got.Equal(tt.next) {
+				t.Errorf("Next(%v) = %v, want %v", after, got.UTC(), tt.next)
+			}
+		})
+	}
+}
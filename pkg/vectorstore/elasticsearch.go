@@ -0,0 +1,218 @@
+package vectorstore
+
+import (
+	"context"
+	"encoding/json"
+
+	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
+	elasticsearchcache "github.com/encoder-run/operator/pkg/cache/elasticsearch"
+	"github.com/encoder-run/operator/pkg/elasticsearch"
+	"github.com/go-git/go-git/v5/storage"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	// The maximum dimension of indexed dense_vector fields.
+	Register(v1alpha1.StorageTypeElasticsearch, newElasticsearchStore, 4096)
+}
+
+// elasticsearchStore keeps the embeddings of all repositories in the code
+// embeddings index, told apart by their url.
+type elasticsearchStore struct {
+	client *elasticsearch.Client
+	url    string
+	index  string
+	// dimension of the dense vectors in the index
+	dimension int
+}
+
+func newElasticsearchStore(ctx context.Context, c client.Client, st *v1alpha1.Storage, url string, dimension int) (VectorStore, error) {
+	secret, err := storageSecret(ctx, c, st, "username", "password")
+	if err != nil {
+		return nil, err
+	}
+
+	return &elasticsearchStore{
+		client:    elasticsearch.NewServiceClient(st.Name, st.Namespace, secret["username"], secret["password"]),
+		url:       url,
+		index:     elasticsearch.CodeEmbeddingsIndex(dimension),
+		dimension: dimension,
+	}, nil
+}
+
+func (s *elasticsearchStore) Init(ctx context.Context) error {
+	if err := elasticsearchcache.CreateIndices(s.client); err != nil {
+		return err
+	}
+	if err := s.client.CreateIndex(s.index, elasticsearch.CodeEmbeddingsMapping(s.dimension)); err != nil {
+		return err
+	}
+	// Indices created before fields were added have a strict mapping without them.
+	return s.client.PutMapping(s.index, elasticsearch.CodeEmbeddingsAddedFields())
+}
+
+func (s *elasticsearchStore) Storer() storage.Storer {
+	return elasticsearchcache.NewStorage(s.client, s.url)
+}
+
+func (s *elasticsearchStore) UpsertChunks(ctx context.Context, chunks []Chunk) error {
+	docs := make([]elasticsearch.BulkDocument, 0, len(chunks))
+	for _, chunk := range chunks {
+		docs = append(docs, elasticsearch.BulkDocument{
+			ID: elasticsearch.CodeEmbeddingID(s.url, chunk.FileHash, chunk.FilePath, chunk.ChunkID),
+			Doc: &elasticsearch.CodeEmbedding{
+				URL:        s.url,
+				FileHash:   chunk.FileHash,
+				FilePath:   chunk.FilePath,
+				ChunkID:    chunk.ChunkID,
+				StartIndex: chunk.StartIndex,
+				EndIndex:   chunk.EndIndex,
+				Embedding:  chunk.Embedding,
+				Symbol:     chunk.Symbol,
+				Refs:       chunk.Refs,
+			},
+		})
+	}
+	if err := s.client.Bulk(s.index, docs); err != nil {
+		return err
+	}
+	// The refs of the chunks are updated by query, which only sees refreshed documents.
+	return s.client.Refresh(s.index)
+}
+
+func (s *elasticsearchStore) DeleteFile(ctx context.Context, fileHash, filePath string) error {
+	return s.client.DeleteByQuery(s.index, s.fileQuery(fileHash, filePath))
+}
+
+func (s *elasticsearchStore) AddFileRef(ctx context.Context, fileHash, filePath, ref string) (bool, error) {
+	total, err := s.client.UpdateByQuery(s.index, s.fileQuery(fileHash, filePath), map[string]interface{}{
+		"source": `if (ctx._source.refs == null) { ctx._source.refs = []; }
+if (ctx._source.refs.contains(params.ref)) { ctx.op = 'noop'; } else { ctx._source.refs.add(params.ref); }`,
+		"params": map[string]interface{}{"ref": ref},
+	})
+	return total > 0, err
+}
+
+func (s *elasticsearchStore) RemoveFileRef(ctx context.Context, fileHash, filePath, ref string) error {
+	_, err := s.client.UpdateByQuery(s.index, s.fileQuery(fileHash, filePath), map[string]interface{}{
+		"source": `if (ctx._source.refs != null) { ctx._source.refs.removeIf(r -> r == params.ref); }
+if (ctx._source.refs == null || ctx._source.refs.isEmpty()) { ctx.op = 'delete'; }`,
+		"params": map[string]interface{}{"ref": ref},
+	})
+	return err
+}
+
+// fileQuery matches the chunks of the file.
+func (s *elasticsearchStore) fileQuery(fileHash, filePath string) map[string]interface{} {
+	return map[string]interface{}{
+		"bool": map[string]interface{}{
+			"filter": []interface{}{
+				map[string]interface{}{"term": map[string]interface{}{"url": s.url}},
+				map[string]interface{}{"term": map[string]interface{}{"fileHash": fileHash}},
+				map[string]interface{}{"term": map[string]interface{}{"filePath": filePath}},
+			},
+		},
+	}
+}
+
+func (s *elasticsearchStore) Query(ctx context.Context, embedding []float32, k int, refs []string) ([]Match, error) {
+	// Set up KNN search restricted to the repository and refs
+	filter := []interface{}{
+		map[string]interface{}{"term": map[string]interface{}{"url": s.url}},
+	}
+	if len(refs) > 0 {
+		filter = append(filter, map[string]interface{}{"terms": map[string]interface{}{"refs": refs}})
+	}
+	body := map[string]interface{}{
+		"knn": map[string]interface{}{
+			"field":          "embedding",
+			"query_vector":   embedding,
+			"k":              k,
+			"num_candidates": 4 * k,
+			"filter":         map[string]interface{}{"bool": map[string]interface{}{"filter": filter}},
+		},
+		"_source": []string{"fileHash", "filePath", "chunkID", "startIndex", "endIndex", "symbol", "refs"},
+		"size":    k,
+	}
+
+	resp, err := s.client.Search(s.index, body)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]Match, 0, len(resp.Hits.Hits))
+	for _, hit := range resp.Hits.Hits {
+		var ce elasticsearch.CodeEmbedding
+		if err := json.Unmarshal(hit.Source, &ce); err != nil {
+			return nil, err
+		}
+		matches = append(matches, Match{
+			ID: hit.ID,
+			Chunk: Chunk{
+				FileHash:   ce.FileHash,
+				FilePath:   ce.FilePath,
+				ChunkID:    ce.ChunkID,
+				StartIndex: ce.StartIndex,
+				EndIndex:   ce.EndIndex,
+				Symbol:     ce.Symbol,
+				Refs:       ce.Refs,
+			},
+			// Elasticsearch scores cosine similarity as (1 + cos) / 2.
+			Distance: 2 * (1 - hit.Score),
+		})
+	}
+	return matches, nil
+}
+
+func (s *elasticsearchStore) Blob(ctx context.Context, hash string) ([]byte, error) {
+	doc, err := s.client.Get(elasticsearch.ObjectsIndex, elasticsearch.DocumentID(s.url, hash))
+	if err != nil {
+		return nil, err
+	}
+	var object elasticsearch.Object
+	if err := json.Unmarshal(doc.Source, &object); err != nil {
+		return nil, err
+	}
+	return object.Blob, nil
+}
+
+func (s *elasticsearchStore) IndexedFiles(ctx context.Context) ([]IndexedFile, error) {
+	seen := make(map[string]bool)
+	files := make([]IndexedFile, 0)
+	var searchAfter []interface{}
+	for {
+		body := map[string]interface{}{
+			"size":    1000,
+			"_source": []string{"fileHash", "filePath", "refs"},
+			"query":   map[string]interface{}{"term": map[string]interface{}{"url": s.url}},
+			"sort":    []interface{}{map[string]interface{}{"fileHash": "asc"}, map[string]interface{}{"filePath": "asc"}, map[string]interface{}{"chunkID": "asc"}},
+		}
+		if searchAfter != nil {
+			body["search_after"] = searchAfter
+		}
+		result, err := s.client.Search(s.index, body)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range result.Hits.Hits {
+			var ce elasticsearch.CodeEmbedding
+			if err := json.Unmarshal(hit.Source, &ce); err != nil {
+				return nil, err
+			}
+			id := ce.FileHash + ":" + ce.FilePath
+			if !seen[id] {
+				seen[id] = true
+				files = append(files, IndexedFile{Hash: ce.FileHash, Path: ce.FilePath, Refs: ce.Refs})
+			}
+		}
+		if len(result.Hits.Hits) < 1000 {
+			return files, nil
+		}
+		searchAfter = result.Hits.Hits[len(result.Hits.Hits)-1].Sort
+	}
+}
+
+// Close is a no-op since the client has no persistent connections.
+func (s *elasticsearchStore) Close() error {
+	return nil
+}
@@ -0,0 +1,192 @@
+package vectorstore
+
+import (
+	"context"
+
+	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
+	postgrescache "github.com/encoder-run/operator/pkg/cache/postgres"
+	"github.com/encoder-run/operator/pkg/database"
+	"github.com/go-git/go-git/v5/storage"
+	"github.com/lib/pq"
+	"github.com/pgvector/pgvector-go"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	// The maximum dimension of pgvector columns.
+	Register(v1alpha1.StorageTypePostgres, newPostgresStore, 16000)
+}
+
+// postgresStore keeps the embeddings of all repositories in the code_embeddings
+// table, told apart by their url. Embeddings that don't have the default dimension
+// are kept in code_embeddings_<dimension>.
+type postgresStore struct {
+	db        *gorm.DB
+	url       string
+	dimension int
+}
+
+func newPostgresStore(ctx context.Context, c client.Client, st *v1alpha1.Storage, url string, dimension int) (VectorStore, error) {
+	secret, err := storageSecret(ctx, c, st, "host", "username", "password", "database", "port", "ssl_mode", "timezone")
+	if err != nil {
+		return nil, err
+	}
+
+	// Construct the DSN
+	dsn := database.ConstructPostgresDSN(secret["host"], secret["username"], secret["password"], secret["database"], secret["port"], secret["ssl_mode"], secret["timezone"])
+	db, err := database.GetPostgresClient(dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgresStore{db: db, url: url, dimension: dimension}, nil
+}
+
+// Init creates the code embeddings table of the dimension, the other tables are
+// migrated when the client is created.
+func (s *postgresStore) Init(ctx context.Context) error {
+	return database.MigrateCodeEmbeddings(s.db.WithContext(ctx), s.dimension)
+}
+
+func (s *postgresStore) table(ctx context.Context) *gorm.DB {
+	return s.db.WithContext(ctx).Table(database.CodeEmbeddingsTable(s.dimension))
+}
+
+func (s *postgresStore) Storer() storage.Storer {
+	return postgrescache.NewStorage(s.db, s.url)
+}
+
+func (s *postgresStore) UpsertChunks(ctx context.Context, chunks []Chunk) error {
+	for _, chunk := range chunks {
+		newEmb := database.CodeEmbedding{
+			URL:        s.url,
+			FileHash:   chunk.FileHash,
+			FilePath:   chunk.FilePath,
+			ChunkID:    chunk.ChunkID,
+			StartIndex: chunk.StartIndex,
+			EndIndex:   chunk.EndIndex,
+			Embedding:  pgvector.NewVector(chunk.Embedding),
+			Symbol:     chunk.Symbol,
+			Refs:       pq.StringArray(chunk.Refs),
+		}
+		// Upsert operation using Clauses with ON CONFLICT
+		if err := s.table(ctx).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "chunk_id"}, {Name: "file_hash"}, {Name: "url"}, {Name: "file_path"}}, // Columns part of the unique constraint
+			DoUpdates: clause.Assignments(map[string]interface{}{ // Update these fields if there is a conflict
+				"start_index": newEmb.StartIndex,
+				"end_index":   newEmb.EndIndex,
+				"embedding":   newEmb.Embedding,
+				"symbol":      newEmb.Symbol,
+				"refs":        newEmb.Refs,
+			}),
+		}).Create(&newEmb).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *postgresStore) DeleteFile(ctx context.Context, fileHash, filePath string) error {
+	return s.table(ctx).
+		Where("url = ? AND file_hash = ? AND file_path = ?", s.url, fileHash, filePath).
+		Delete(&database.CodeEmbedding{}).Error
+}
+
+func (s *postgresStore) AddFileRef(ctx context.Context, fileHash, filePath, ref string) (bool, error) {
+	// Every chunk of the file is updated so that the affected rows tell if it is indexed.
+	result := s.table(ctx).
+		Where("url = ? AND file_hash = ? AND file_path = ?", s.url, fileHash, filePath).
+		Update("refs", gorm.Expr("array_append(array_remove(refs, ?), ?)", ref, ref))
+	return result.RowsAffected > 0, result.Error
+}
+
+func (s *postgresStore) RemoveFileRef(ctx context.Context, fileHash, filePath, ref string) error {
+	if err := s.table(ctx).
+		Where("url = ? AND file_hash = ? AND file_path = ?", s.url, fileHash, filePath).
+		Update("refs", gorm.Expr("array_remove(refs, ?)", ref)).Error; err != nil {
+		return err
+	}
+	return s.table(ctx).
+		Where("url = ? AND file_hash = ? AND file_path = ? AND coalesce(cardinality(refs), 0) = 0", s.url, fileHash, filePath).
+		Delete(&database.CodeEmbedding{}).Error
+}
+
+func (s *postgresStore) Query(ctx context.Context, embedding []float32, k int, refs []string) ([]Match, error) {
+	var rows []struct {
+		FileHash   string
+		FilePath   string
+		ChunkID    int
+		StartIndex int
+		EndIndex   int
+		Symbol     string
+		Refs       pq.StringArray
+		Distance   float64
+	}
+	query := s.table(ctx).Where("url = ?", s.url)
+	if len(refs) > 0 {
+		// && is true if the arrays have a ref in common.
+		query = query.Where("refs && ?", pq.StringArray(refs))
+	}
+	// <=> is the cosine distance operator of pgvector.
+	if err := query.
+		Select("file_hash, file_path, chunk_id, start_index, end_index, symbol, refs, embedding <=> ? AS distance", pgvector.NewVector(embedding)).
+		Order("distance ASC").
+		Limit(k).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	matches := make([]Match, 0, len(rows))
+	for _, row := range rows {
+		matches = append(matches, Match{
+			ID: row.FileHash,
+			Chunk: Chunk{
+				FileHash:   row.FileHash,
+				FilePath:   row.FilePath,
+				ChunkID:    row.ChunkID,
+				StartIndex: row.StartIndex,
+				EndIndex:   row.EndIndex,
+				Symbol:     row.Symbol,
+				Refs:       row.Refs,
+			},
+			Distance: row.Distance,
+		})
+	}
+	return matches, nil
+}
+
+func (s *postgresStore) Blob(ctx context.Context, hash string) ([]byte, error) {
+	object := database.Object{}
+	// Select by hash, blob type, and url
+	if err := s.db.WithContext(ctx).Where("hash = ? AND type = ? AND url = ?", hash, "blob", s.url).First(&object).Error; err != nil {
+		return nil, err
+	}
+	return object.Blob, nil
+}
+
+func (s *postgresStore) IndexedFiles(ctx context.Context) ([]IndexedFile, error) {
+	var rows []struct {
+		Hash string
+		Path string
+		Refs pq.StringArray
+	}
+	// The chunks of a file share their refs.
+	if err := s.table(ctx).
+		Select("DISTINCT file_hash AS hash, file_path AS path, refs").
+		Where("url = ?", s.url).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	files := make([]IndexedFile, 0, len(rows))
+	for _, row := range rows {
+		files = append(files, IndexedFile{Hash: row.Hash, Path: row.Path, Refs: row.Refs})
+	}
+	return files, nil
+}
+
+// Close is a no-op since the database connections are shared.
+func (s *postgresStore) Close() error {
+	return nil
+}
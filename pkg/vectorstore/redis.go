@@ -0,0 +1,530 @@
+package vectorstore
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/RediSearch/redisearch-go/v2/redisearch"
+	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
+	rediscache "github.com/encoder-run/operator/pkg/cache/redis"
+	"github.com/encoder-run/operator/pkg/common"
+	"github.com/go-git/go-git/v5/storage"
+	redigoredis "github.com/gomodule/redigo/redis"
+	"github.com/redis/go-redis/v9"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func init() {
+	// The maximum dimension of RediSearch vector fields.
+	Register(v1alpha1.StorageTypeRedis, newRedisStore, 32768)
+}
+
+// refsSeparator separates the refs of a chunk in its refs tag field.
+const refsSeparator = ','
+
+// redisStore keeps the embeddings of a repository as hashes under the
+// <url>:embedding:code: prefix, indexed by a RediSearch index named <url>:embedding.
+// The chunk keys of every file are kept in a set under <url>:embedding:file:, and
+// the keys of these sets in the set <url>:embedding:files, so that the chunks of a
+// file are found without scanning the keyspace. Embeddings that don't have the
+// default dimension use <url>:embedding:<dimension> instead of <url>:embedding.
+type redisStore struct {
+	opts      *redis.Options
+	client    *redis.Client
+	search    *redisearch.Client
+	url       string
+	dimension int
+}
+
+func newRedisStore(ctx context.Context, c client.Client, st *v1alpha1.Storage, url string, dimension int) (VectorStore, error) {
+	secret, err := storageSecret(ctx, c, st, "password")
+	if err != nil {
+		return nil, err
+	}
+
+	opts := &redis.Options{
+		Addr:     common.RedisServiceURL(st.Name, st.Namespace),
+		Password: secret["password"],
+		DB:       0,
+	}
+	pool := &redigoredis.Pool{Dial: func() (redigoredis.Conn, error) {
+		return redigoredis.Dial("tcp", opts.Addr, redigoredis.DialPassword(opts.Password))
+	}}
+
+	s := &redisStore{
+		opts:      opts,
+		client:    redis.NewClient(opts),
+		url:       url,
+		dimension: dimension,
+	}
+	s.search = redisearch.NewClientFromPool(pool, s.embeddingPrefix())
+	return s, nil
+}
+
+func (s *redisStore) Init(ctx context.Context) error {
+	// Create a schema for the index
+	sc := redisearch.NewSchema(redisearch.DefaultOptions).
+		AddField(redisearch.NewTextField("file_hash")).
+		AddField(redisearch.NewTextField("file_path")).
+		AddField(redisearch.NewNumericField("chunk_id")).
+		AddField(redisearch.NewNumericField("start_index")).
+		AddField(redisearch.NewNumericField("end_index")).
+		AddField(refsField()).
+		AddField(redisearch.NewVectorFieldOptions("embedding", redisearch.VectorFieldOptions{
+			Algorithm: redisearch.Flat,
+			Attributes: map[string]interface{}{
+				"TYPE":            "FLOAT32",
+				"DIM":             s.dimension,
+				"DISTANCE_METRIC": "COSINE",
+			},
+		}))
+
+	indexDef := redisearch.NewIndexDefinition().AddPrefix(s.codePrefix())
+
+	info, _ := s.search.Info()
+	if info == nil {
+		// Create the index with the schema. The shards of an execution initialize the
+		// store concurrently, the index may have been created since.
+		if err := s.search.CreateIndexWithIndexDefinition(sc, indexDef); err != nil && !isIndexExists(err) {
+			return err
+		}
+		return nil
+	}
+	// Chunks stored before the chunk keys of the files were kept are added to the
+	// sets of their files once.
+	if info.DocCount > 0 {
+		exists, err := s.client.Exists(ctx, s.filesKey()).Result()
+		if err != nil {
+			return err
+		}
+		if exists == 0 {
+			if err := s.addScannedChunks(ctx); err != nil {
+				return err
+			}
+		}
+	}
+	// Indices created before refs were recorded don't have the refs field.
+	for _, f := range info.Schema.Fields {
+		if f.Name == "refs" {
+			return nil
+		}
+	}
+	return s.search.AddField(refsField())
+}
+
+// isIndexExists reports whether the error of FT.CREATE is due to the index existing.
+func isIndexExists(err error) bool {
+	return strings.Contains(strings.ToLower(err.Error()), "index already exists")
+}
+
+func refsField() redisearch.Field {
+	return redisearch.NewTagFieldOptions("refs", redisearch.TagFieldOptions{Separator: refsSeparator, CaseSensitive: true})
+}
+
+func (s *redisStore) Storer() storage.Storer {
+	return rediscache.NewStorage(s.opts, s.url)
+}
+
+func (s *redisStore) UpsertChunks(ctx context.Context, chunks []Chunk) error {
+	docs := make([]redisearch.Document, 0, len(chunks))
+	for _, chunk := range chunks {
+		doc := redisearch.NewDocument(s.chunkKey(chunk), 1.0)
+		doc.Set("fileHash", chunk.FileHash)
+		doc.Set("filePath", chunk.FilePath)
+		doc.Set("chunkID", chunk.ChunkID)
+		doc.Set("startIndex", chunk.StartIndex)
+		doc.Set("endIndex", chunk.EndIndex)
+		doc.Set("symbol", chunk.Symbol)
+		doc.Set("refs", joinRefs(chunk.Refs))
+		// Convert embedding float slice to bytes
+		buf := new(bytes.Buffer)
+		if err := binary.Write(buf, binary.LittleEndian, chunk.Embedding); err != nil {
+			return err
+		}
+		doc.Set("embedding", buf.Bytes())
+		docs = append(docs, doc)
+	}
+	if len(docs) == 0 {
+		return nil
+	}
+
+	if err := s.search.IndexOptions(redisearch.IndexingOptions{
+		Replace: true,
+	}, docs...); err != nil {
+		return err
+	}
+
+	pipe := s.client.Pipeline()
+	for _, chunk := range chunks {
+		fileKey := s.fileKey(chunk.FileHash, chunk.FilePath)
+		pipe.SAdd(ctx, fileKey, s.chunkKey(chunk))
+		pipe.SAdd(ctx, s.filesKey(), fileKey)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *redisStore) DeleteFile(ctx context.Context, fileHash, filePath string) error {
+	keys, err := s.client.SMembers(ctx, s.fileKey(fileHash, filePath)).Result()
+	if err != nil {
+		return err
+	}
+	return s.deleteChunks(ctx, fileHash, filePath, keys)
+}
+
+func (s *redisStore) AddFileRef(ctx context.Context, fileHash, filePath, ref string) (bool, error) {
+	found := false
+	err := s.fileChunks(ctx, fileHash, filePath, func(key string, f IndexedFile) error {
+		found = true
+		if containsRef(f.Refs, ref) {
+			return nil
+		}
+		return s.client.HSet(ctx, key, "refs", joinRefs(append(f.Refs, ref))).Err()
+	})
+	return found, err
+}
+
+func (s *redisStore) RemoveFileRef(ctx context.Context, fileHash, filePath, ref string) error {
+	var unreferenced []string
+	err := s.fileChunks(ctx, fileHash, filePath, func(key string, f IndexedFile) error {
+		refs := make([]string, 0, len(f.Refs))
+		for _, r := range f.Refs {
+			if r != ref {
+				refs = append(refs, r)
+			}
+		}
+		if len(refs) == 0 {
+			unreferenced = append(unreferenced, key)
+			return nil
+		}
+		return s.client.HSet(ctx, key, "refs", joinRefs(refs)).Err()
+	})
+	if err != nil {
+		return err
+	}
+	return s.deleteChunks(ctx, fileHash, filePath, unreferenced)
+}
+
+func (s *redisStore) Query(ctx context.Context, embedding []float32, k int, refs []string) ([]Match, error) {
+	// Query vector represented as blob
+	buf := new(bytes.Buffer)
+	if err := binary.Write(buf, binary.LittleEndian, embedding); err != nil {
+		return nil, err
+	}
+
+	// Set up KNN search
+	filter := "*"
+	if len(refs) > 0 {
+		escaped := make([]string, 0, len(refs))
+		for _, ref := range refs {
+			escaped = append(escaped, escapeTag(ref))
+		}
+		filter = fmt.Sprintf("(@refs:{%s})", strings.Join(escaped, "|"))
+	}
+	knnQuery := fmt.Sprintf("%s=>[KNN %d @embedding $B AS __vec_score]", filter, k)
+
+	redisQuery := redisearch.NewQuery(knnQuery).
+		SetParams(map[string]interface{}{"B": buf.Bytes()}).
+		SetSortBy("__vec_score", true). // Sort by the vector score
+		AddReturnFields("__vec_score", "chunkID", "fileHash", "filePath", "startIndex", "endIndex", "symbol", "refs").
+		SetDialect(2).
+		Limit(0, k)
+
+	docs, _, err := s.search.Search(redisQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search documents: %w", err)
+	}
+
+	matches := make([]Match, 0, len(docs))
+	for _, doc := range docs {
+		m, err := redisDocToMatch(&doc)
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, *m)
+	}
+	return matches, nil
+}
+
+func (s *redisStore) Blob(ctx context.Context, hash string) ([]byte, error) {
+	key := fmt.Sprintf("%s:%s:%s:%s", s.url, "object", "blob", hash)
+	return s.client.Get(ctx, key).Bytes()
+}
+
+func (s *redisStore) IndexedFiles(ctx context.Context) ([]IndexedFile, error) {
+	files := make([]IndexedFile, 0)
+	var cursor uint64
+	for {
+		fileKeys, next, err := s.client.SScan(ctx, s.filesKey(), cursor, "", 1000).Result()
+		if err != nil {
+			return nil, err
+		}
+
+		// The chunks of a file share its refs, any chunk of the file describes it.
+		pipe := s.client.Pipeline()
+		cmds := make([]*redis.StringCmd, len(fileKeys))
+		for i, fileKey := range fileKeys {
+			cmds[i] = pipe.SRandMember(ctx, fileKey)
+		}
+		if len(fileKeys) > 0 {
+			if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+				return nil, err
+			}
+		}
+		keys := make([]string, 0, len(cmds))
+		for _, cmd := range cmds {
+			if key := cmd.Val(); key != "" {
+				keys = append(keys, key)
+			}
+		}
+		if err := s.chunks(ctx, keys, func(key string, f IndexedFile) error {
+			files = append(files, f)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return files, nil
+		}
+	}
+}
+
+func (s *redisStore) Close() error {
+	return s.client.Close()
+}
+
+// embeddingPrefix is also the name of the RediSearch index.
+func (s *redisStore) embeddingPrefix() string {
+	if s.dimension == v1alpha1.DefaultEmbeddingDimension {
+		return fmt.Sprintf("%s:embedding", s.url)
+	}
+	return fmt.Sprintf("%s:embedding:%d", s.url, s.dimension)
+}
+
+func (s *redisStore) codePrefix() string {
+	return fmt.Sprintf("%s:code:", s.embeddingPrefix())
+}
+
+// chunkKey returns the key of a chunk. The path is hashed into the key so that
+// identical files at different paths don't overwrite each other.
+func (s *redisStore) chunkKey(chunk Chunk) string {
+	return fmt.Sprintf("%s%s:%s:%d", s.codePrefix(), chunk.FileHash, pathSum(chunk.FilePath), chunk.ChunkID)
+}
+
+// fileKey returns the key of the set of the chunk keys of a file.
+func (s *redisStore) fileKey(fileHash, filePath string) string {
+	return fmt.Sprintf("%s:file:%s:%s", s.embeddingPrefix(), fileHash, pathSum(filePath))
+}
+
+// filesKey returns the key of the set of the file keys of the files with chunks.
+func (s *redisStore) filesKey() string {
+	return fmt.Sprintf("%s:files", s.embeddingPrefix())
+}
+
+func pathSum(path string) string {
+	sum := sha1.Sum([]byte(path))
+	return hex.EncodeToString(sum[:])
+}
+
+// fileChunks calls fn with every chunk key of the file.
+func (s *redisStore) fileChunks(ctx context.Context, fileHash, filePath string, fn func(key string, f IndexedFile) error) error {
+	keys, err := s.client.SMembers(ctx, s.fileKey(fileHash, filePath)).Result()
+	if err != nil {
+		return err
+	}
+	return s.chunks(ctx, keys, fn)
+}
+
+// chunks calls fn with the file of every chunk key.
+func (s *redisStore) chunks(ctx context.Context, keys []string, fn func(key string, f IndexedFile) error) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	pipe := s.client.Pipeline()
+	cmds := make([]*redis.SliceCmd, len(keys))
+	for i, key := range keys {
+		cmds[i] = pipe.HMGet(ctx, key, "fileHash", "filePath", "refs")
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+	for i, cmd := range cmds {
+		values := cmd.Val()
+		hash, _ := values[0].(string)
+		path, _ := values[1].(string)
+		refs, _ := values[2].(string)
+		if err := fn(keys[i], IndexedFile{Hash: hash, Path: path, Refs: splitRefs(refs)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteChunks deletes the chunk keys of the file, and the file once it has no
+// chunks left.
+func (s *redisStore) deleteChunks(ctx context.Context, fileHash, filePath string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	fileKey := s.fileKey(fileHash, filePath)
+	members := make([]interface{}, len(keys))
+	for i, key := range keys {
+		members[i] = key
+	}
+	pipe := s.client.Pipeline()
+	pipe.Del(ctx, keys...)
+	pipe.SRem(ctx, fileKey, members...)
+	remaining := pipe.SCard(ctx, fileKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+	if remaining.Val() > 0 {
+		return nil
+	}
+	return s.client.SRem(ctx, s.filesKey(), fileKey).Err()
+}
+
+// addScannedChunks adds every chunk key in the keyspace to the set of its file.
+func (s *redisStore) addScannedChunks(ctx context.Context) error {
+	var cursor uint64
+	for {
+		keys, next, err := s.client.Scan(ctx, cursor, s.codePrefix()+"*", 1000).Result()
+		if err != nil {
+			return err
+		}
+		pipe := s.client.Pipeline()
+		if err := s.chunks(ctx, keys, func(key string, f IndexedFile) error {
+			fileKey := s.fileKey(f.Hash, f.Path)
+			pipe.SAdd(ctx, fileKey, key)
+			pipe.SAdd(ctx, s.filesKey(), fileKey)
+			return nil
+		}); err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if _, err := pipe.Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
+func redisDocToMatch(doc *redisearch.Document) (*Match, error) {
+	m := &Match{ID: doc.Id}
+
+	// Get the chunk id
+	chunkIDString, ok := doc.Properties["chunkID"].(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to convert chunk to int")
+	}
+	chunkID, err := strconv.Atoi(chunkIDString)
+	if err != nil {
+		return nil, err
+	}
+	m.ChunkID = chunkID
+
+	// Get the hash
+	hash, ok := doc.Properties["fileHash"].(string)
+	if !ok {
+		return nil, fmt.Errorf("hash property not found in the document")
+	}
+	m.FileHash = hash
+
+	// Get the file path
+	path, ok := doc.Properties["filePath"].(string)
+	if !ok {
+		return nil, fmt.Errorf("filePath property not found in the document")
+	}
+	m.FilePath = path
+
+	// Get the score
+	scoreString, ok := doc.Properties["__vec_score"].(string)
+	if !ok {
+		return nil, fmt.Errorf("vec_score property not found in the document")
+	}
+	score, err := strconv.ParseFloat(scoreString, 64)
+	if err != nil {
+		return nil, err
+	}
+	m.Distance = score
+
+	// Get the start index
+	startIndexString, ok := doc.Properties["startIndex"].(string)
+	if !ok {
+		return nil, fmt.Errorf("startIndex property not found in the document")
+	}
+	startIndex, err := strconv.Atoi(startIndexString)
+	if err != nil {
+		return nil, err
+	}
+	m.StartIndex = startIndex
+
+	// Get the end index
+	endIndexString, ok := doc.Properties["endIndex"].(string)
+	if !ok {
+		return nil, fmt.Errorf("endIndex property not found in the document")
+	}
+	endIndex, err := strconv.Atoi(endIndexString)
+	if err != nil {
+		return nil, err
+	}
+	m.EndIndex = endIndex
+
+	// Get the symbol, chunks stored before symbols were recorded don't have one
+	if symbol, ok := doc.Properties["symbol"].(string); ok {
+		m.Symbol = symbol
+	}
+
+	// Get the refs, chunks stored before refs were recorded don't have any
+	if refs, ok := doc.Properties["refs"].(string); ok {
+		m.Refs = splitRefs(refs)
+	}
+
+	return m, nil
+}
+
+func joinRefs(refs []string) string {
+	return strings.Join(refs, string(refsSeparator))
+}
+
+func splitRefs(refs string) []string {
+	if refs == "" {
+		return nil
+	}
+	return strings.Split(refs, string(refsSeparator))
+}
+
+func containsRef(refs []string, ref string) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// escapeTag escapes the punctuation of a tag value in a query, e.g. the slashes of a ref.
+func escapeTag(value string) string {
+	var b strings.Builder
+	for _, r := range value {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,114 @@
+package vectorstore
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/RediSearch/redisearch-go/v2/redisearch"
+	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
+)
+
+func TestRedisDocToMatch(t *testing.T) {
+	properties := func(drop string) map[string]interface{} {
+		p := map[string]interface{}{
+			"chunkID":     "2",
+			"fileHash":    "abc",
+			"filePath":    "cmd/main.go",
+			"__vec_score": "0.25",
+			"startIndex":  "120",
+			"endIndex":    "480",
+		}
+		delete(p, drop)
+		return p
+	}
+
+	tests := []struct {
+		name       string
+		properties map[string]interface{}
+		want       *Match
+		wantErr    bool
+	}{
+		{
+			name:       "all properties",
+			properties: properties(""),
+			want: &Match{
+				ID:       "key",
+				Chunk:    Chunk{FileHash: "abc", FilePath: "cmd/main.go", ChunkID: 2, StartIndex: 120, EndIndex: 480},
+				Distance: 0.25,
+			},
+		},
+		{name: "missing file path", properties: properties("filePath"), wantErr: true},
+		{name: "missing score", properties: properties("__vec_score"), wantErr: true},
+		{name: "missing end index", properties: properties("endIndex"), wantErr: true},
+		{
+			name: "invalid chunk id",
+			properties: func() map[string]interface{} {
+				p := properties("")
+				p["chunkID"] = "first"
+				return p
+			}(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := redisDocToMatch(&redisearch.Document{Id: "key", Properties: tt.properties})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("redisDocToMatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("redisDocToMatch() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsIndexExists(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: errors.New("Index already exists"), want: true},
+		{err: errors.New("Unknown index name"), want: false},
+		{err: errors.New("dial tcp: connection refused"), want: false},
+	}
+	for _, tt := range tests {
+		if got := isIndexExists(tt.err); got != tt.want {
+			t.Errorf("isIndexExists(%q) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestRedisKeys(t *testing.T) {
+	tests := []struct {
+		name      string
+		dimension int
+		wantFiles string
+	}{
+		{name: "default dimension", dimension: v1alpha1.DefaultEmbeddingDimension, wantFiles: "github.com/o/r:embedding:files"},
+		{name: "other dimension", dimension: 1536, wantFiles: "github.com/o/r:embedding:1536:files"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &redisStore{url: "github.com/o/r", dimension: tt.dimension}
+			if got := s.filesKey(); got != tt.wantFiles {
+				t.Errorf("filesKey() = %q, want %q", got, tt.wantFiles)
+			}
+
+			// The sets of the files are not indexed with the chunks.
+			fileKey := s.fileKey("abc", "cmd/main.go")
+			if strings.HasPrefix(fileKey, s.codePrefix()) {
+				t.Errorf("fileKey() = %q, want it outside of %q", fileKey, s.codePrefix())
+			}
+			if other := s.fileKey("abc", "cmd/other.go"); other == fileKey {
+				t.Errorf("fileKey() of another path = %q, want it to differ", other)
+			}
+			chunk := s.chunkKey(Chunk{FileHash: "abc", FilePath: "cmd/main.go", ChunkID: 1})
+			if !strings.HasPrefix(chunk, s.codePrefix()) {
+				t.Errorf("chunkKey() = %q, want it below %q", chunk, s.codePrefix())
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package vectorstore abstracts the storages that hold the git objects and code
+// embeddings of a repository. Every storage type registers a Factory, so adding a
+// backend only requires implementing VectorStore.
+package vectorstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
+	"github.com/go-git/go-git/v5/storage"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Chunk is the embedding of a part of a file.
+type Chunk struct {
+	FileHash   string
+	FilePath   string
+	ChunkID    int
+	StartIndex int
+	EndIndex   int
+	Embedding  []float32
+	// Symbol is the name of the declaration of the chunk if known
+	Symbol string
+	// Refs are the full names of the refs the file is in, e.g. refs/heads/main
+	Refs []string
+}
+
+// Match is a chunk returned by a KNN query. The embedding is not set.
+type Match struct {
+	ID string
+	Chunk
+	// Distance is the cosine distance between the query and the chunk.
+	Distance float64
+}
+
+// SnapshotRef returns the ref the files of the tree of an indexed commit are labeled
+// with, so the commit can be searched after its refs moved on.
+func SnapshotRef(commit string) string {
+	return "refs/snapshots/" + commit
+}
+
+// IndexedFile is a file that has embeddings in the store.
+type IndexedFile struct {
+	Hash string
+	Path string
+	// Refs the file is in, empty for files indexed before refs were recorded
+	Refs []string
+}
+
+// VectorStore stores everything about a single repository.
+type VectorStore interface {
+	// Init creates the indices or tables needed by the store. It is idempotent.
+	Init(ctx context.Context) error
+	// Storer returns the go-git storage of the repository.
+	Storer() storage.Storer
+	// UpsertChunks creates or replaces the embeddings of the chunks.
+	UpsertChunks(ctx context.Context, chunks []Chunk) error
+	// DeleteFile deletes the embeddings of all chunks of the file.
+	DeleteFile(ctx context.Context, fileHash, filePath string) error
+	// AddFileRef adds the ref to the chunks of the file. It returns false if the file
+	// has no embeddings, so identical files are only embedded once across refs.
+	AddFileRef(ctx context.Context, fileHash, filePath, ref string) (bool, error)
+	// RemoveFileRef removes the ref from the chunks of the file and deletes the
+	// embeddings of the file if it is left without refs.
+	RemoveFileRef(ctx context.Context, fileHash, filePath, ref string) error
+	// Query returns the k chunks nearest to the embedding, nearest first. If refs are
+	// given only chunks of files in any of the refs are returned.
+	Query(ctx context.Context, embedding []float32, k int, refs []string) ([]Match, error)
+	// Blob returns the content of the git blob with the given hash.
+	Blob(ctx context.Context, hash string) ([]byte, error)
+	// IndexedFiles returns all files that have embeddings in the store.
+	IndexedFiles(ctx context.Context) ([]IndexedFile, error)
+	// Close releases the connections of the store.
+	Close() error
+}
+
+// Factory creates the VectorStore of the repository with the given url. The
+// connection details are read from the secret of the storage. Embeddings of
+// different dimensions are kept in separate indices or tables.
+type Factory func(ctx context.Context, c client.Client, st *v1alpha1.Storage, url string, dimension int) (VectorStore, error)
+
+type registration struct {
+	factory      Factory
+	maxDimension int
+}
+
+var (
+	mu            sync.RWMutex
+	registrations = make(map[v1alpha1.StorageType]registration)
+)
+
+// Register makes a storage type available to New. maxDimension is the largest
+// embedding dimension the storage can index.
+func Register(storageType v1alpha1.StorageType, factory Factory, maxDimension int) {
+	mu.Lock()
+	defer mu.Unlock()
+	registrations[storageType] = registration{factory: factory, maxDimension: maxDimension}
+}
+
+func lookup(storageType v1alpha1.StorageType) (registration, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	r, ok := registrations[storageType]
+	if !ok {
+		return registration{}, fmt.Errorf("unsupported storage type: %s", storageType)
+	}
+	return r, nil
+}
+
+// ValidateDimension checks that the storage type can index embeddings of the dimension.
+func ValidateDimension(storageType v1alpha1.StorageType, dimension int) error {
+	r, err := lookup(storageType)
+	if err != nil {
+		return err
+	}
+	if dimension < 1 || dimension > r.maxDimension {
+		return fmt.Errorf("embedding dimension %d is not supported by storage type %s, the maximum is %d", dimension, storageType, r.maxDimension)
+	}
+	return nil
+}
+
+// New creates the VectorStore for the repository with the given url in the storage.
+func New(ctx context.Context, c client.Client, st *v1alpha1.Storage, url string, dimension int) (VectorStore, error) {
+	if err := ValidateDimension(st.Spec.Type, dimension); err != nil {
+		return nil, err
+	}
+	r, err := lookup(st.Spec.Type)
+	if err != nil {
+		return nil, err
+	}
+	return r.factory(ctx, c, st, url, dimension)
+}
+
+// storageSecret returns the values of the secret named after the storage. All keys
+// are required.
+func storageSecret(ctx context.Context, c client.Client, st *v1alpha1.Storage, keys ...string) (map[string]string, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: st.Name, Namespace: st.Namespace}, secret); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(keys))
+	for _, key := range keys {
+		value, ok := secret.Data[key]
+		if !ok {
+			return nil, fmt.Errorf("%s not found in secret", key)
+		}
+		values[key] = string(value)
+	}
+	return values, nil
+}
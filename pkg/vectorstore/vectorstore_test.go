@@ -0,0 +1,129 @@
+package vectorstore
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1alpha1 "github.com/encoder-run/operator/api/cloud/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// testStore is the VectorStore created by the factory of the test storage type.
+type testStore struct {
+	VectorStore
+	url       string
+	dimension int
+}
+
+func TestNew(t *testing.T) {
+	const testStorageType v1alpha1.StorageType = "TEST"
+	Register(testStorageType, func(ctx context.Context, c client.Client, st *v1alpha1.Storage, url string, dimension int) (VectorStore, error) {
+		return &testStore{url: url, dimension: dimension}, nil
+	}, 1024)
+
+	tests := []struct {
+		name        string
+		storageType v1alpha1.StorageType
+		dimension   int
+		wantErr     bool
+	}{
+		{name: "registered storage type", storageType: testStorageType, dimension: 768},
+		{name: "unsupported storage type", storageType: "UNKNOWN", dimension: 768, wantErr: true},
+		{name: "unsupported dimension", storageType: testStorageType, dimension: 2048, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &v1alpha1.Storage{Spec: v1alpha1.StorageSpec{Type: tt.storageType}}
+			got, err := New(context.Background(), nil, st, "github.com/encoder-run/operator", tt.dimension)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if store, ok := got.(*testStore); !ok || store.url != "github.com/encoder-run/operator" || store.dimension != tt.dimension {
+				t.Errorf("New() = %v, want the store of the registered factory", got)
+			}
+		})
+	}
+}
+
+func TestValidateDimension(t *testing.T) {
+	const testStorageType v1alpha1.StorageType = "TEST_DIMENSION"
+	Register(testStorageType, func(ctx context.Context, c client.Client, st *v1alpha1.Storage, url string, dimension int) (VectorStore, error) {
+		return nil, nil
+	}, 2048)
+
+	tests := []struct {
+		name        string
+		storageType v1alpha1.StorageType
+		dimension   int
+		wantErr     bool
+	}{
+		{name: "default dimension", storageType: testStorageType, dimension: v1alpha1.DefaultEmbeddingDimension},
+		{name: "maximum dimension", storageType: testStorageType, dimension: 2048},
+		{name: "above the maximum", storageType: testStorageType, dimension: 2049, wantErr: true},
+		{name: "zero dimension", storageType: testStorageType, dimension: 0, wantErr: true},
+		{name: "unsupported storage type", storageType: "UNKNOWN", dimension: 768, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateDimension(tt.storageType, tt.dimension); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDimension() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStorageSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    map[string][]byte
+		keys    []string
+		want    map[string]string
+		wantErr bool
+	}{
+		{
+			name: "all keys",
+			data: map[string][]byte{"username": []byte("elastic"), "password": []byte("secret"), "other": []byte("value")},
+			keys: []string{"username", "password"},
+			want: map[string]string{"username": "elastic", "password": "secret"},
+		},
+		{
+			name:    "missing key",
+			data:    map[string][]byte{"username": []byte("elastic")},
+			keys:    []string{"username", "password"},
+			wantErr: true,
+		},
+		{
+			name:    "missing secret",
+			keys:    []string{"password"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			if tt.data != nil {
+				builder = builder.WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "storage", Namespace: "default"},
+					Data:       tt.data,
+				})
+			}
+			st := &v1alpha1.Storage{ObjectMeta: metav1.ObjectMeta{Name: "storage", Namespace: "default"}}
+
+			got, err := storageSecret(context.Background(), builder.Build(), st, tt.keys...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("storageSecret() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("storageSecret() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}